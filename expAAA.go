@@ -0,0 +1,406 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// aaaStats represents the data returned from the /stat/aaa Nitro API endpoint
+type aaaStats struct {
+	CurSessions         json.Number `json:"aaacursessions"`
+	CurICASessions      json.Number `json:"aaacuricasessions"`
+	CurICAConn          json.Number `json:"aaacuricaconn"`
+	CurICAOnlyConn      json.Number `json:"aaacuricaonlyconn"`
+	AuthSuccess         json.Number `json:"aaaauthsuccess"`
+	AuthFail            json.Number `json:"aaaauthfail"`
+	AuthOnlyHTTPSuccess json.Number `json:"aaaauthonlyhttpsuccess"`
+	AuthOnlyHTTPFail    json.Number `json:"aaaauthonlyhttpfail"`
+	AuthNonHTTPSuccess  json.Number `json:"aaaauthnonhttpsuccess"`
+	AuthNonHTTPFail     json.Number `json:"aaaauthnonhttpfail"`
+	TotSessions         json.Number `json:"aaatotsessions"`
+	TotSessionTimeout   json.Number `json:"aaatotsessiontimeout"`
+	CurTMSessions       json.Number `json:"aaacurtmsessions"`
+	TotTMSessions       json.Number `json:"aaatottmsessions"`
+}
+
+// authenticationVirtualServerStats represents the data returned from the /stat/authenticationvserver Nitro API endpoint
+type authenticationVirtualServerStats struct {
+	Name               string      `json:"name"`
+	State              string      `json:"state"`
+	TotalRequests      json.Number `json:"totalrequests"`
+	TotalResponses     json.Number `json:"totalresponses"`
+	TotalRequestBytes  json.Number `json:"totalrequestbytes"`
+	TotalResponseBytes json.Number `json:"totalresponsebytes"`
+}
+
+// getAAAStats queries the Nitro API for AAA stats
+func getAAAStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "aaa", querystring)
+}
+
+// getAuthenticationVirtualServerStats queries the Nitro API for authentication virtual server stats
+func getAuthenticationVirtualServerStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "authenticationvserver", querystring)
+}
+
+const aaaSubsystem = "aaa"
+
+const authenticationVirtualServersSubsystem = "authentication_vserver"
+
+var aaaLabels = []string{
+	netscalerInstance,
+}
+
+var authenticationVirtualServersLabels = []string{
+	netscalerInstance,
+	`citrixadc_authentication_vserver`,
+}
+
+var (
+	aaaCurrentSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_sessions",
+			Help:      "Number of AAA user sessions, including NetScaler Gateway VPN and ICA sessions",
+		},
+		aaaLabels,
+	)
+
+	aaaCurrentICASessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_ica_sessions",
+			Help:      "Number of ICA user sessions through NetScaler Gateway",
+		},
+		aaaLabels,
+	)
+
+	aaaCurrentICAConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_ica_connections",
+			Help:      "Number of ICA connections through NetScaler Gateway",
+		},
+		aaaLabels,
+	)
+
+	aaaCurrentICAOnlyConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_ica_only_connections",
+			Help:      "Number of ICA connections through NetScaler Gateway in ICA only (basic) mode",
+		},
+		aaaLabels,
+	)
+
+	aaaAuthSuccess = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "auth_success_total",
+			Help:      "Total number of successful user logins",
+		},
+		aaaLabels,
+	)
+
+	aaaAuthFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "auth_failures_total",
+			Help:      "Total number of failed user logins",
+		},
+		aaaLabels,
+	)
+
+	aaaSessionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "sessions_total",
+			Help:      "Total number of AAA user sessions established",
+		},
+		aaaLabels,
+	)
+
+	aaaSessionTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "session_timeouts_total",
+			Help:      "Total number of AAA user sessions which timed out",
+		},
+		aaaLabels,
+	)
+
+	aaaCurrentTMSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "current_tm_sessions",
+			Help:      "Number of AAA traffic management (TM) sessions",
+		},
+		aaaLabels,
+	)
+
+	aaaTMSessionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "tm_sessions_total",
+			Help:      "Total number of AAA traffic management (TM) sessions established",
+		},
+		aaaLabels,
+	)
+
+	aaaHTTPAuthSuccess = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "http_auth_success_total",
+			Help:      "Total number of successful authentications of HTTP traffic",
+		},
+		aaaLabels,
+	)
+
+	aaaHTTPAuthFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "http_auth_failures_total",
+			Help:      "Total number of failed authentications of HTTP traffic",
+		},
+		aaaLabels,
+	)
+
+	aaaNonHTTPAuthSuccess = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "non_http_auth_success_total",
+			Help:      "Total number of successful authentications of non-HTTP traffic",
+		},
+		aaaLabels,
+	)
+
+	aaaNonHTTPAuthFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: aaaSubsystem,
+			Name:      "non_http_auth_failures_total",
+			Help:      "Total number of failed authentications of non-HTTP traffic",
+		},
+		aaaLabels,
+	)
+
+	authenticationVirtualServersState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: authenticationVirtualServersSubsystem,
+			Name:      "state",
+			Help:      "Current state of the authentication virtual server. 0 = DOWN, 1 = UP, 2 = OUT OF SERVICE, 3 = UNKNOWN",
+		},
+		authenticationVirtualServersLabels,
+	)
+
+	authenticationVirtualServersTotalRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: authenticationVirtualServersSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received by the authentication virtual server",
+		},
+		authenticationVirtualServersLabels,
+	)
+
+	authenticationVirtualServersTotalResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: authenticationVirtualServersSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses sent by the authentication virtual server",
+		},
+		authenticationVirtualServersLabels,
+	)
+
+	authenticationVirtualServersTotalRequestBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: authenticationVirtualServersSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received by the authentication virtual server",
+		},
+		authenticationVirtualServersLabels,
+	)
+
+	authenticationVirtualServersTotalResponseBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: authenticationVirtualServersSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes sent by the authentication virtual server",
+		},
+		authenticationVirtualServersLabels,
+	)
+)
+
+func (e *Exporter) collectAAACurrentSessions(ns nitroResponse) {
+	e.aaaCurrentSessions.Reset()
+
+	val, _ := ns.AAAStats.CurSessions.Float64()
+	e.aaaCurrentSessions.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAACurrentICASessions(ns nitroResponse) {
+	e.aaaCurrentICASessions.Reset()
+
+	val, _ := ns.AAAStats.CurICASessions.Float64()
+	e.aaaCurrentICASessions.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAACurrentICAConnections(ns nitroResponse) {
+	e.aaaCurrentICAConnections.Reset()
+
+	val, _ := ns.AAAStats.CurICAConn.Float64()
+	e.aaaCurrentICAConnections.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAACurrentICAOnlyConnections(ns nitroResponse) {
+	e.aaaCurrentICAOnlyConnections.Reset()
+
+	val, _ := ns.AAAStats.CurICAOnlyConn.Float64()
+	e.aaaCurrentICAOnlyConnections.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAAAuthSuccess(ns nitroResponse) {
+	e.aaaAuthSuccess.Reset()
+
+	val, _ := ns.AAAStats.AuthSuccess.Float64()
+	e.aaaAuthSuccess.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAAAuthFailures(ns nitroResponse) {
+	e.aaaAuthFailures.Reset()
+
+	val, _ := ns.AAAStats.AuthFail.Float64()
+	e.aaaAuthFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAASessionsTotal(ns nitroResponse) {
+	e.aaaSessionsTotal.Reset()
+
+	val, _ := ns.AAAStats.TotSessions.Float64()
+	e.aaaSessionsTotal.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAASessionTimeouts(ns nitroResponse) {
+	e.aaaSessionTimeouts.Reset()
+
+	val, _ := ns.AAAStats.TotSessionTimeout.Float64()
+	e.aaaSessionTimeouts.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAACurrentTMSessions(ns nitroResponse) {
+	e.aaaCurrentTMSessions.Reset()
+
+	val, _ := ns.AAAStats.CurTMSessions.Float64()
+	e.aaaCurrentTMSessions.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAATMSessionsTotal(ns nitroResponse) {
+	e.aaaTMSessionsTotal.Reset()
+
+	val, _ := ns.AAAStats.TotTMSessions.Float64()
+	e.aaaTMSessionsTotal.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAAHTTPAuthSuccess(ns nitroResponse) {
+	e.aaaHTTPAuthSuccess.Reset()
+
+	val, _ := ns.AAAStats.AuthOnlyHTTPSuccess.Float64()
+	e.aaaHTTPAuthSuccess.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAAHTTPAuthFailures(ns nitroResponse) {
+	e.aaaHTTPAuthFailures.Reset()
+
+	val, _ := ns.AAAStats.AuthOnlyHTTPFail.Float64()
+	e.aaaHTTPAuthFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAANonHTTPAuthSuccess(ns nitroResponse) {
+	e.aaaNonHTTPAuthSuccess.Reset()
+
+	val, _ := ns.AAAStats.AuthNonHTTPSuccess.Float64()
+	e.aaaNonHTTPAuthSuccess.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAAANonHTTPAuthFailures(ns nitroResponse) {
+	e.aaaNonHTTPAuthFailures.Reset()
+
+	val, _ := ns.AAAStats.AuthNonHTTPFail.Float64()
+	e.aaaNonHTTPAuthFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAuthenticationVirtualServersState(ns nitroResponse) {
+	e.authenticationVirtualServersState.Reset()
+
+	for _, vs := range ns.AuthenticationVirtualServerStats {
+		var state float64
+		switch vs.State {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		case `OUT OF SERVICE`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+
+		e.authenticationVirtualServersState.WithLabelValues(e.nsInstance, vs.Name).Set(state)
+	}
+}
+
+func (e *Exporter) collectAuthenticationVirtualServersTotalRequests(ns nitroResponse) {
+	e.authenticationVirtualServersTotalRequests.Reset()
+
+	for _, vs := range ns.AuthenticationVirtualServerStats {
+		val, _ := vs.TotalRequests.Float64()
+		e.authenticationVirtualServersTotalRequests.WithLabelValues(e.nsInstance, vs.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAuthenticationVirtualServersTotalResponses(ns nitroResponse) {
+	e.authenticationVirtualServersTotalResponses.Reset()
+
+	for _, vs := range ns.AuthenticationVirtualServerStats {
+		val, _ := vs.TotalResponses.Float64()
+		e.authenticationVirtualServersTotalResponses.WithLabelValues(e.nsInstance, vs.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAuthenticationVirtualServersTotalRequestBytes(ns nitroResponse) {
+	e.authenticationVirtualServersTotalRequestBytes.Reset()
+
+	for _, vs := range ns.AuthenticationVirtualServerStats {
+		val, _ := vs.TotalRequestBytes.Float64()
+		e.authenticationVirtualServersTotalRequestBytes.WithLabelValues(e.nsInstance, vs.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAuthenticationVirtualServersTotalResponseBytes(ns nitroResponse) {
+	e.authenticationVirtualServersTotalResponseBytes.Reset()
+
+	for _, vs := range ns.AuthenticationVirtualServerStats {
+		val, _ := vs.TotalResponseBytes.Float64()
+		e.authenticationVirtualServersTotalResponseBytes.WithLabelValues(e.nsInstance, vs.Name).Set(val)
+	}
+}
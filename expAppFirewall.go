@@ -0,0 +1,423 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// appfwStats represents the data returned from the /stat/appfw Nitro API endpoint
+type appfwStats struct {
+	Requests             json.Number `json:"appfirewallrequests"`
+	Responses            json.Number `json:"appfirewallresponses"`
+	RequestBytes         json.Number `json:"appfirewallreqbytes"`
+	ResponseBytes        json.Number `json:"appfirewallresbytes"`
+	Aborts               json.Number `json:"appfirewallaborts"`
+	Redirects            json.Number `json:"appfirewallredirects"`
+	TotalViolations      json.Number `json:"appfirewalltotalviol"`
+	ViolStartURL         json.Number `json:"appfirewallviolstarturl"`
+	ViolDenyURL          json.Number `json:"appfirewallvioldenyurl"`
+	ViolRefererHeader    json.Number `json:"appfirewallviolrefererheader"`
+	ViolBufferOverflow   json.Number `json:"appfirewallviolbufferoverflow"`
+	ViolCookie           json.Number `json:"appfirewallviolcookie"`
+	ViolCSRFTag          json.Number `json:"appfirewallviolcsrftag"`
+	ViolXSS              json.Number `json:"appfirewallviolxss"`
+	ViolSQLInjection     json.Number `json:"appfirewallviolsql"`
+	ViolFieldFormat      json.Number `json:"appfirewallviolfieldformat"`
+	ViolFieldConsistency json.Number `json:"appfirewallviolfieldconsistency"`
+	ViolCreditCard       json.Number `json:"appfirewallviolcreditcard"`
+	ViolSafeObject       json.Number `json:"appfirewallviolsafeobject"`
+	ViolSignature        json.Number `json:"appfirewallviolsignature"`
+	ViolContentType      json.Number `json:"appfirewallviolcontenttype"`
+	ViolWellFormedness   json.Number `json:"appfirewallviolwellformednessviolations"`
+	ViolXDoS             json.Number `json:"appfirewallviolxdosviolations"`
+	ViolMsgValidation    json.Number `json:"appfirewallviolmsgvalviolations"`
+	ViolWSI              json.Number `json:"appfirewallviolwsiviolations"`
+	ViolXMLSQLInjection  json.Number `json:"appfirewallviolxmlsqlviolations"`
+	ViolXMLXSS           json.Number `json:"appfirewallviolxmlxssviolations"`
+	ViolXMLAttachment    json.Number `json:"appfirewallviolxmlattachmentviolations"`
+	ViolXMLSOAPFault     json.Number `json:"appfirewallviolxmlsoapfaultviolations"`
+	ViolXMLGeneric       json.Number `json:"appfirewallviolxmlgenviolations"`
+}
+
+// appfwProfileStats represents the data returned from the /stat/appfwprofile Nitro API endpoint.
+// Unlike the global stats, the profile counters aren't prefixed with appfirewall
+type appfwProfileStats struct {
+	Name                 string      `json:"name"`
+	Requests             json.Number `json:"requests"`
+	Responses            json.Number `json:"responses"`
+	Aborts               json.Number `json:"aborts"`
+	Redirects            json.Number `json:"redirects"`
+	TotalViolations      json.Number `json:"totalviol"`
+	ViolStartURL         json.Number `json:"violstarturl"`
+	ViolDenyURL          json.Number `json:"violdenyurl"`
+	ViolRefererHeader    json.Number `json:"violrefererheader"`
+	ViolBufferOverflow   json.Number `json:"violbufferoverflow"`
+	ViolCookie           json.Number `json:"violcookie"`
+	ViolCSRFTag          json.Number `json:"violcsrftag"`
+	ViolXSS              json.Number `json:"violxss"`
+	ViolSQLInjection     json.Number `json:"violsql"`
+	ViolFieldFormat      json.Number `json:"violfieldformat"`
+	ViolFieldConsistency json.Number `json:"violfieldconsistency"`
+	ViolCreditCard       json.Number `json:"violcreditcard"`
+	ViolSafeObject       json.Number `json:"violsafeobject"`
+	ViolSignature        json.Number `json:"violsignature"`
+	ViolContentType      json.Number `json:"violcontenttype"`
+	ViolWellFormedness   json.Number `json:"violwellformednessviolations"`
+	ViolXDoS             json.Number `json:"violxdosviolations"`
+	ViolMsgValidation    json.Number `json:"violmsgvalviolations"`
+	ViolWSI              json.Number `json:"violwsiviolations"`
+	ViolXMLSQLInjection  json.Number `json:"violxmlsqlviolations"`
+	ViolXMLXSS           json.Number `json:"violxmlxssviolations"`
+	ViolXMLAttachment    json.Number `json:"violxmlattachmentviolations"`
+	ViolXMLSOAPFault     json.Number `json:"violxmlsoapfaultviolations"`
+	ViolXMLGeneric       json.Number `json:"violxmlgenviolations"`
+}
+
+// getAppFWStats queries the Nitro API for application firewall stats
+func getAppFWStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "appfw", querystring)
+}
+
+// getAppFWProfileStats queries the Nitro API for application firewall profile stats
+func getAppFWProfileStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "appfwprofile", querystring)
+}
+
+// violations breaks the violations down by security check
+func (s appfwStats) violations() map[string]json.Number {
+	return map[string]json.Number{
+		"start_url":              s.ViolStartURL,
+		"deny_url":               s.ViolDenyURL,
+		"referer_header":         s.ViolRefererHeader,
+		"buffer_overflow":        s.ViolBufferOverflow,
+		"cookie":                 s.ViolCookie,
+		"csrf_tag":               s.ViolCSRFTag,
+		"xss":                    s.ViolXSS,
+		"sql_injection":          s.ViolSQLInjection,
+		"field_format":           s.ViolFieldFormat,
+		"field_consistency":      s.ViolFieldConsistency,
+		"credit_card":            s.ViolCreditCard,
+		"safe_object":            s.ViolSafeObject,
+		"signature":              s.ViolSignature,
+		"content_type":           s.ViolContentType,
+		"xml_format":             s.ViolWellFormedness,
+		"xml_dos":                s.ViolXDoS,
+		"xml_message_validation": s.ViolMsgValidation,
+		"xml_wsi":                s.ViolWSI,
+		"xml_sql_injection":      s.ViolXMLSQLInjection,
+		"xml_xss":                s.ViolXMLXSS,
+		"xml_attachment":         s.ViolXMLAttachment,
+		"xml_soap_fault":         s.ViolXMLSOAPFault,
+		"xml_generic":            s.ViolXMLGeneric,
+	}
+}
+
+// violations breaks the profile violations down by security check
+func (s appfwProfileStats) violations() map[string]json.Number {
+	return map[string]json.Number{
+		"start_url":              s.ViolStartURL,
+		"deny_url":               s.ViolDenyURL,
+		"referer_header":         s.ViolRefererHeader,
+		"buffer_overflow":        s.ViolBufferOverflow,
+		"cookie":                 s.ViolCookie,
+		"csrf_tag":               s.ViolCSRFTag,
+		"xss":                    s.ViolXSS,
+		"sql_injection":          s.ViolSQLInjection,
+		"field_format":           s.ViolFieldFormat,
+		"field_consistency":      s.ViolFieldConsistency,
+		"credit_card":            s.ViolCreditCard,
+		"safe_object":            s.ViolSafeObject,
+		"signature":              s.ViolSignature,
+		"content_type":           s.ViolContentType,
+		"xml_format":             s.ViolWellFormedness,
+		"xml_dos":                s.ViolXDoS,
+		"xml_message_validation": s.ViolMsgValidation,
+		"xml_wsi":                s.ViolWSI,
+		"xml_sql_injection":      s.ViolXMLSQLInjection,
+		"xml_xss":                s.ViolXMLXSS,
+		"xml_attachment":         s.ViolXMLAttachment,
+		"xml_soap_fault":         s.ViolXMLSOAPFault,
+		"xml_generic":            s.ViolXMLGeneric,
+	}
+}
+
+const appfwSubsystem = "appfw"
+
+var appfwLabels = []string{
+	netscalerInstance,
+}
+
+var appfwCheckLabels = []string{
+	netscalerInstance,
+	`citrixadc_appfw_check`,
+}
+
+var appfwProfileLabels = []string{
+	netscalerInstance,
+	`citrixadc_appfw_profile`,
+}
+
+var appfwProfileCheckLabels = []string{
+	netscalerInstance,
+	`citrixadc_appfw_profile`,
+	`citrixadc_appfw_check`,
+}
+
+var (
+	appfwRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses sent by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwRequestBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwResponseBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes sent by the application firewall",
+		},
+		appfwLabels,
+	)
+
+	appfwAborts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "aborts_total",
+			Help:      "Total number of requests blocked by the application firewall by aborting the connection",
+		},
+		appfwLabels,
+	)
+
+	appfwRedirects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "redirects_total",
+			Help:      "Total number of requests blocked by the application firewall by redirecting to the error page",
+		},
+		appfwLabels,
+	)
+
+	appfwViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "violations_total",
+			Help:      "Total number of security check violations seen by the application firewall, whether blocked or only logged",
+		},
+		appfwLabels,
+	)
+
+	appfwCheckViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "check_violations_total",
+			Help:      "Number of violations of the security check seen by the application firewall",
+		},
+		appfwCheckLabels,
+	)
+
+	appfwProfileRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_requests_total",
+			Help:      "Total number of requests handled by the application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_responses_total",
+			Help:      "Total number of responses handled by the application firewall profile",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileAborts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_aborts_total",
+			Help:      "Total number of requests blocked by the application firewall profile by aborting the connection",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileRedirects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_redirects_total",
+			Help:      "Total number of requests blocked by the application firewall profile by redirecting to the error page",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_violations_total",
+			Help:      "Total number of security check violations seen by the application firewall profile, whether blocked or only logged",
+		},
+		appfwProfileLabels,
+	)
+
+	appfwProfileCheckViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: appfwSubsystem,
+			Name:      "profile_check_violations_total",
+			Help:      "Number of violations of the security check seen by the application firewall profile",
+		},
+		appfwProfileCheckLabels,
+	)
+)
+
+func (e *Exporter) collectAppFWRequests(ns nitroResponse) {
+	e.appfwRequests.Reset()
+
+	val, _ := ns.AppFWStats.Requests.Float64()
+	e.appfwRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWResponses(ns nitroResponse) {
+	e.appfwResponses.Reset()
+
+	val, _ := ns.AppFWStats.Responses.Float64()
+	e.appfwResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWRequestBytes(ns nitroResponse) {
+	e.appfwRequestBytes.Reset()
+
+	val, _ := ns.AppFWStats.RequestBytes.Float64()
+	e.appfwRequestBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWResponseBytes(ns nitroResponse) {
+	e.appfwResponseBytes.Reset()
+
+	val, _ := ns.AppFWStats.ResponseBytes.Float64()
+	e.appfwResponseBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWAborts(ns nitroResponse) {
+	e.appfwAborts.Reset()
+
+	val, _ := ns.AppFWStats.Aborts.Float64()
+	e.appfwAborts.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWRedirects(ns nitroResponse) {
+	e.appfwRedirects.Reset()
+
+	val, _ := ns.AppFWStats.Redirects.Float64()
+	e.appfwRedirects.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWViolations(ns nitroResponse) {
+	e.appfwViolations.Reset()
+
+	val, _ := ns.AppFWStats.TotalViolations.Float64()
+	e.appfwViolations.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectAppFWCheckViolations(ns nitroResponse) {
+	e.appfwCheckViolations.Reset()
+
+	for check, v := range ns.AppFWStats.violations() {
+		val, _ := v.Float64()
+		e.appfwCheckViolations.WithLabelValues(e.nsInstance, check).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileRequests(ns nitroResponse) {
+	e.appfwProfileRequests.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		val, _ := p.Requests.Float64()
+		e.appfwProfileRequests.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileResponses(ns nitroResponse) {
+	e.appfwProfileResponses.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		val, _ := p.Responses.Float64()
+		e.appfwProfileResponses.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileAborts(ns nitroResponse) {
+	e.appfwProfileAborts.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		val, _ := p.Aborts.Float64()
+		e.appfwProfileAborts.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileRedirects(ns nitroResponse) {
+	e.appfwProfileRedirects.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		val, _ := p.Redirects.Float64()
+		e.appfwProfileRedirects.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileViolations(ns nitroResponse) {
+	e.appfwProfileViolations.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		val, _ := p.TotalViolations.Float64()
+		e.appfwProfileViolations.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectAppFWProfileCheckViolations(ns nitroResponse) {
+	e.appfwProfileCheckViolations.Reset()
+
+	for _, p := range ns.AppFWProfileStats {
+		for check, v := range p.violations() {
+			val, _ := v.Float64()
+			e.appfwProfileCheckViolations.WithLabelValues(e.nsInstance, p.Name, check).Set(val)
+		}
+	}
+}
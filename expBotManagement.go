@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// botProfileStats represents the data returned from the /stat/botprofile Nitro API endpoint.
+// Bot management was introduced in 13.0, and older firmware returns a Nitro error for the resource, so it's only queried on 13.0 and later
+type botProfileStats struct {
+	Name                  string      `json:"name"`
+	WhitelistHits         json.Number `json:"botwhitelisthits"`
+	BlacklistHits         json.Number `json:"botblacklisthits"`
+	RateLimitHits         json.Number `json:"botratelimithits"`
+	SignatureHits         json.Number `json:"botsignaturehits"`
+	TPSHits               json.Number `json:"bottpshits"`
+	DeviceFingerprintHits json.Number `json:"botdevicefingerprinthits"`
+	IPReputationHits      json.Number `json:"botiprephits"`
+	CaptchaHits           json.Number `json:"botcaptchahits"`
+	TrapHits              json.Number `json:"bottraphits"`
+	Logs                  json.Number `json:"botlogs"`
+	Drops                 json.Number `json:"botdrops"`
+	Redirects             json.Number `json:"botredirects"`
+	Resets                json.Number `json:"botresets"`
+}
+
+// getBotProfileStats queries the Nitro API for bot management profile stats
+func getBotProfileStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "botprofile", querystring)
+}
+
+// botManagementSupported reports whether the firmware release has bot management, i.e. whether it's 13.0 or later.
+// A version which can't be parsed is assumed to support it, so the bot resources are still queried if the version format changes
+func botManagementSupported(version string) bool {
+	release, build := parseVersion(version)
+	if build == "" {
+		return true
+	}
+	major, err := strconv.Atoi(strings.SplitN(release, ".", 2)[0])
+	if err != nil {
+		return true
+	}
+	return major >= 13
+}
+
+// detections breaks the bot detections down by detection category.  ip_reputation only covers the bot profile's own IP reputation check
+func (s botProfileStats) detections() map[string]json.Number {
+	return map[string]json.Number{
+		"whitelist":          s.WhitelistHits,
+		"blacklist":          s.BlacklistHits,
+		"rate_limit":         s.RateLimitHits,
+		"signature":          s.SignatureHits,
+		"tps":                s.TPSHits,
+		"device_fingerprint": s.DeviceFingerprintHits,
+		"ip_reputation":      s.IPReputationHits,
+		"captcha":            s.CaptchaHits,
+		"trap":               s.TrapHits,
+	}
+}
+
+// actions breaks the actions taken on detected bots down by action
+func (s botProfileStats) actions() map[string]json.Number {
+	return map[string]json.Number{
+		"log":      s.Logs,
+		"drop":     s.Drops,
+		"redirect": s.Redirects,
+		"reset":    s.Resets,
+	}
+}
+
+const botSubsystem = "bot"
+
+var botProfileCategoryLabels = []string{
+	netscalerInstance,
+	`citrixadc_bot_profile`,
+	`citrixadc_bot_category`,
+}
+
+var botProfileActionLabels = []string{
+	netscalerInstance,
+	`citrixadc_bot_profile`,
+	`citrixadc_bot_action`,
+}
+
+var (
+	botProfileDetections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: botSubsystem,
+			Name:      "profile_detections_total",
+			Help:      "Number of requests detected as bots by the bot profile, by detection category",
+		},
+		botProfileCategoryLabels,
+	)
+
+	botProfileActions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: botSubsystem,
+			Name:      "profile_actions_total",
+			Help:      "Number of times the bot profile took an action on a detected bot, by action",
+		},
+		botProfileActionLabels,
+	)
+)
+
+func (e *Exporter) collectBotProfileDetections(ns nitroResponse) {
+	e.botProfileDetections.Reset()
+
+	for _, p := range ns.BotProfileStats {
+		for category, v := range p.detections() {
+			// Detection categories added in later firmware aren't reported by older releases
+			if v == "" {
+				continue
+			}
+			val, _ := v.Float64()
+			e.botProfileDetections.WithLabelValues(e.nsInstance, p.Name, category).Set(val)
+		}
+	}
+}
+
+func (e *Exporter) collectBotProfileActions(ns nitroResponse) {
+	e.botProfileActions.Reset()
+
+	for _, p := range ns.BotProfileStats {
+		for action, v := range p.actions() {
+			if v == "" {
+				continue
+			}
+			val, _ := v.Float64()
+			e.botProfileActions.WithLabelValues(e.nsInstance, p.Name, action).Set(val)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestBotManagementSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected bool
+	}{
+		{
+			name:     "13.0",
+			version:  "NetScaler NS13.0: Build 47.24.nc, Date: Nov 29 2019, 11:58:51   (64-bit)",
+			expected: true,
+		},
+		{
+			name:     "12.1",
+			version:  "NetScaler NS12.1: Build 55.18.nc, Date: Oct 29 2019, 07:14:44   (64-bit)",
+			expected: false,
+		},
+		{
+			name:     "11.1",
+			version:  "NetScaler NS11.1: Build 63.9.e.nc, Date: Nov 14 2019, 12:47:33   (64-bit)",
+			expected: false,
+		},
+		{
+			name:     "unparseable",
+			version:  "Citrix ADC unknown release",
+			expected: true,
+		},
+		{
+			name:     "empty",
+			version:  "",
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if supported := botManagementSupported(tc.version); supported != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, supported)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ const csVirtualServersSubsystem = "cs_vserver"
 var csVirtualServersLabels = []string{
 	netscalerInstance,
 	`citrixadc_cs_name`,
+	`citrixadc_traffic_domain`,
 }
 
 var (
@@ -211,7 +212,7 @@ func (e *Exporter) collectCSVirtualServerState(ns netscaler.NSAPIResponse) {
 		default:
 			state = 3.0
 		}
-		e.csVirtualServersState.WithLabelValues(e.nsInstance, vs.Name).Set(state)
+		e.csVirtualServersState.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(state)
 	}
 }
 
@@ -219,7 +220,7 @@ func (e *Exporter) collectCSVirtualServerTotalHits(ns netscaler.NSAPIResponse) {
 	e.csVirtualServersTotalHits.Reset()
 	for _, vs := range ns.CSVirtualServerStats {
 		totalHits, _ := strconv.ParseFloat(vs.TotalHits, 64)
-		e.csVirtualServersTotalHits.WithLabelValues(e.nsInstance, vs.Name).Set(totalHits)
+		e.csVirtualServersTotalHits.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalHits)
 	}
 }
 
@@ -228,7 +229,7 @@ func (e *Exporter) collectCSVirtualServerTotalRequests(ns netscaler.NSAPIRespons
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalRequests, _ := strconv.ParseFloat(vs.TotalRequests, 64)
-		e.csVirtualServersTotalRequests.WithLabelValues(e.nsInstance, vs.Name).Set(totalRequests)
+		e.csVirtualServersTotalRequests.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalRequests)
 	}
 }
 
@@ -237,7 +238,7 @@ func (e *Exporter) collectCSVirtualServerTotalResponses(ns netscaler.NSAPIRespon
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalResponses, _ := strconv.ParseFloat(vs.TotalResponses, 64)
-		e.csVirtualServersTotalResponses.WithLabelValues(e.nsInstance, vs.Name).Set(totalResponses)
+		e.csVirtualServersTotalResponses.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalResponses)
 	}
 }
 
@@ -246,7 +247,7 @@ func (e *Exporter) collectCSVirtualServerTotalRequestBytes(ns netscaler.NSAPIRes
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalRequestBytes, _ := strconv.ParseFloat(vs.TotalRequestBytes, 64)
-		e.csVirtualServersTotalRequestBytes.WithLabelValues(e.nsInstance, vs.Name).Set(totalRequestBytes)
+		e.csVirtualServersTotalRequestBytes.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalRequestBytes)
 	}
 }
 
@@ -255,7 +256,7 @@ func (e *Exporter) collectCSVirtualServerTotalResponseBytes(ns netscaler.NSAPIRe
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalResponseBytes, _ := strconv.ParseFloat(vs.TotalResponseBytes, 64)
-		e.csVirtualServersTotalResponseBytes.WithLabelValues(e.nsInstance, vs.Name).Set(totalResponseBytes)
+		e.csVirtualServersTotalResponseBytes.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalResponseBytes)
 	}
 }
 
@@ -264,7 +265,7 @@ func (e *Exporter) collectCSVirtualServerCurrentClientConnections(ns netscaler.N
 
 	for _, vs := range ns.CSVirtualServerStats {
 		currentClientConnections, _ := strconv.ParseFloat(vs.CurrentClientConnections, 64)
-		e.csVirtualServersCurrentClientConnections.WithLabelValues(e.nsInstance, vs.Name).Set(currentClientConnections)
+		e.csVirtualServersCurrentClientConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(currentClientConnections)
 	}
 }
 
@@ -273,7 +274,7 @@ func (e *Exporter) collectCSVirtualServerCurrentServerConnections(ns netscaler.N
 
 	for _, vs := range ns.CSVirtualServerStats {
 		currentServerConnections, _ := strconv.ParseFloat(vs.CurrentServerConnections, 64)
-		e.csVirtualServersCurrentServerConnections.WithLabelValues(e.nsInstance, vs.Name).Set(currentServerConnections)
+		e.csVirtualServersCurrentServerConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(currentServerConnections)
 	}
 }
 
@@ -282,7 +283,7 @@ func (e *Exporter) collectCSVirtualServerEstablishedConnections(ns netscaler.NSA
 
 	for _, vs := range ns.CSVirtualServerStats {
 		EstablishedConnections, _ := strconv.ParseFloat(vs.EstablishedConnections, 64)
-		e.csVirtualServersEstablishedConnections.WithLabelValues(e.nsInstance, vs.Name).Set(EstablishedConnections)
+		e.csVirtualServersEstablishedConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(EstablishedConnections)
 	}
 }
 
@@ -291,7 +292,7 @@ func (e *Exporter) collectCSVirtualServerTotalPacketsReceived(ns netscaler.NSAPI
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalPacketsReceived, _ := strconv.ParseFloat(vs.TotalPacketsReceived, 64)
-		e.csVirtualServersTotalPacketsReceived.WithLabelValues(e.nsInstance, vs.Name).Set(totalPacketsReceived)
+		e.csVirtualServersTotalPacketsReceived.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalPacketsReceived)
 	}
 }
 
@@ -300,7 +301,7 @@ func (e *Exporter) collectCSVirtualServerTotalPacketsSent(ns netscaler.NSAPIResp
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalPacketsSent, _ := strconv.ParseFloat(vs.TotalPacketsSent, 64)
-		e.csVirtualServersTotalPacketsSent.WithLabelValues(e.nsInstance, vs.Name).Set(totalPacketsSent)
+		e.csVirtualServersTotalPacketsSent.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalPacketsSent)
 	}
 }
 
@@ -309,7 +310,7 @@ func (e *Exporter) collectCSVirtualServerTotalSpillovers(ns netscaler.NSAPIRespo
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalSpillovers, _ := strconv.ParseFloat(vs.TotalSpillovers, 64)
-		e.csVirtualServersTotalSpillovers.WithLabelValues(e.nsInstance, vs.Name).Set(totalSpillovers)
+		e.csVirtualServersTotalSpillovers.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalSpillovers)
 	}
 }
 
@@ -318,7 +319,7 @@ func (e *Exporter) collectCSVirtualServerDeferredRequests(ns netscaler.NSAPIResp
 
 	for _, vs := range ns.CSVirtualServerStats {
 		deferredRequests, _ := strconv.ParseFloat(vs.DeferredRequests, 64)
-		e.csVirtualServersDeferredRequests.WithLabelValues(e.nsInstance, vs.Name).Set(deferredRequests)
+		e.csVirtualServersDeferredRequests.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(deferredRequests)
 	}
 }
 
@@ -327,7 +328,7 @@ func (e *Exporter) collectCSVirtualServerNumberInvalidRequestResponse(ns netscal
 
 	for _, vs := range ns.CSVirtualServerStats {
 		numberInvalidRequestResponse, _ := strconv.ParseFloat(vs.InvalidRequestResponse, 64)
-		e.csVirtualServersNumberInvalidRequestResponse.WithLabelValues(e.nsInstance, vs.Name).Set(numberInvalidRequestResponse)
+		e.csVirtualServersNumberInvalidRequestResponse.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(numberInvalidRequestResponse)
 	}
 }
 
@@ -336,7 +337,7 @@ func (e *Exporter) collectCSVirtualServerNumberInvalidRequestResponseDropped(ns
 
 	for _, vs := range ns.CSVirtualServerStats {
 		numberInvalidRequestResponseDropped, _ := strconv.ParseFloat(vs.InvalidRequestResponseDropped, 64)
-		e.csVirtualServersNumberInvalidRequestResponseDropped.WithLabelValues(e.nsInstance, vs.Name).Set(numberInvalidRequestResponseDropped)
+		e.csVirtualServersNumberInvalidRequestResponseDropped.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(numberInvalidRequestResponseDropped)
 	}
 }
 
@@ -345,7 +346,7 @@ func (e *Exporter) collectCSVirtualServerTotalVServerDownBackupHits(ns netscaler
 
 	for _, vs := range ns.CSVirtualServerStats {
 		totalVServerDownBackupHits, _ := strconv.ParseFloat(vs.TotalVServerDownBackupHits, 64)
-		e.csVirtualServersTotalVServerDownBackupHits.WithLabelValues(e.nsInstance, vs.Name).Set(totalVServerDownBackupHits)
+		e.csVirtualServersTotalVServerDownBackupHits.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(totalVServerDownBackupHits)
 	}
 }
 
@@ -355,7 +356,7 @@ func (e *Exporter) collectCSVirtualServerCurrentMultipathSessions(ns netscaler.N
 
 	for _, vs := range ns.CSVirtualServerStats {
 		currentMultipathSessions, _ := strconv.ParseFloat(vs.CurrentMultipathSessions, 64)
-		e.csVirtualServersCurrentMultipathSessions.WithLabelValues(e.nsInstance, vs.Name).Set(currentMultipathSessions)
+		e.csVirtualServersCurrentMultipathSessions.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(currentMultipathSessions)
 	}
 }
 
@@ -365,7 +366,7 @@ func (e *Exporter) collectCSVirtualServerCurrentMultipathSubflows(ns netscaler.N
 
 	for _, vs := range ns.CSVirtualServerStats {
 		currentMultipathSubflows, _ := strconv.ParseFloat(vs.CurrentMultipathSubflows, 64)
-		e.csVirtualServersCurrentMultipathSubflows.WithLabelValues(e.nsInstance, vs.Name).Set(currentMultipathSubflows)
+		e.csVirtualServersCurrentMultipathSubflows.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)).Set(currentMultipathSubflows)
 	}
 }
 */
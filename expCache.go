@@ -0,0 +1,358 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// cacheStats represents the data returned from the /stat/cache Nitro API endpoint
+type cacheStats struct {
+	TotalHits                   json.Number `json:"cachetothits"`
+	TotalMisses                 json.Number `json:"cachetotmisses"`
+	TotalRequests               json.Number `json:"cachetotrequests"`
+	Total304Hits                json.Number `json:"cachetot304hits"`
+	TotalStoreableMisses        json.Number `json:"cachetotstoreablemisses"`
+	TotalNonStoreableMisses     json.Number `json:"cachetotnonstoreablemisses"`
+	FlashCacheHits              json.Number `json:"cacheflashcachehit"`
+	FlashCacheMisses            json.Number `json:"cacheflashcachemiss"`
+	BytesServed                 json.Number `json:"cachebytesserved"`
+	PercentOriginBandwidthSaved float64     `json:"cachepercentoriginbandwidthsaved"`
+	NumCached                   json.Number `json:"cachenumcached"`
+	UtilizedMemoryKB            json.Number `json:"cacheutilizedmemorykb"`
+	MaxMemoryKB                 json.Number `json:"cachemaxmemorykb"`
+}
+
+// cacheContentGroups represents the data returned from the /config/cachecontentgroup Nitro API endpoint.
+// Nitro reports the per content group counters as read-only fields of the content group config
+type cacheContentGroups struct {
+	Name       string      `json:"name"`
+	MemUsage   json.Number `json:"memusage"`
+	CacheCells json.Number `json:"cachecells"`
+	Hits       json.Number `json:"hits"`
+	Misses     json.Number `json:"misses"`
+}
+
+// getCacheStats queries the Nitro API for integrated cache stats
+func getCacheStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "cache", querystring)
+}
+
+// getCacheContentGroups queries the Nitro API for integrated cache content groups
+func getCacheContentGroups(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "cachecontentgroup", querystring)
+}
+
+const cacheSubsystem = "cache"
+
+var cacheLabels = []string{
+	netscalerInstance,
+}
+
+var cacheContentGroupLabels = []string{
+	netscalerInstance,
+	`citrixadc_cache_contentgroup`,
+}
+
+var (
+	cacheTotalHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "hits_total",
+			Help:      "Total number of cache hits",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "misses_total",
+			Help:      "Total number of cache misses",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of cache requests, which is the sum of hits and misses",
+		},
+		cacheLabels,
+	)
+
+	cacheTotal304Hits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "not_modified_hits_total",
+			Help:      "Total number of 304 (Not Modified) responses served from the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalStoreableMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "storable_misses_total",
+			Help:      "Total number of cache misses for which the object was stored in the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalNonStoreableMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "non_storable_misses_total",
+			Help:      "Total number of cache misses for which the object could not be stored in the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalFlashCacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "flash_cache_hits_total",
+			Help:      "Total number of requests served by flash cache",
+		},
+		cacheLabels,
+	)
+
+	cacheTotalFlashCacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "flash_cache_misses_total",
+			Help:      "Total number of requests which flash cache could not serve",
+		},
+		cacheLabels,
+	)
+
+	cacheServedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "served_bytes_total",
+			Help:      "Total number of bytes served from the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheOriginBandwidthSaved = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "origin_bandwidth_saved_pct",
+			Help:      "Bandwidth to the origin servers which was saved by serving responses from the cache, as a percentage",
+		},
+		cacheLabels,
+	)
+
+	cacheCachedObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "cached_objects",
+			Help:      "Number of objects currently in the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheMemoryUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "memory_used_bytes",
+			Help:      "Memory currently used by the cache",
+		},
+		cacheLabels,
+	)
+
+	cacheMemoryMax = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "memory_max_bytes",
+			Help:      "Maximum memory the cache is allowed to use",
+		},
+		cacheLabels,
+	)
+
+	cacheContentGroupMemoryUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "contentgroup_memory_used_bytes",
+			Help:      "Memory currently used by the content group",
+		},
+		cacheContentGroupLabels,
+	)
+
+	cacheContentGroupCachedObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "contentgroup_cached_objects",
+			Help:      "Number of objects currently cached in the content group",
+		},
+		cacheContentGroupLabels,
+	)
+
+	cacheContentGroupHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "contentgroup_hits_total",
+			Help:      "Total number of requests served from the content group",
+		},
+		cacheContentGroupLabels,
+	)
+
+	cacheContentGroupMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: cacheSubsystem,
+			Name:      "contentgroup_misses_total",
+			Help:      "Total number of requests for the content group which couldn't be served from the cache",
+		},
+		cacheContentGroupLabels,
+	)
+)
+
+func (e *Exporter) collectCacheTotalHits(ns nitroResponse) {
+	e.cacheTotalHits.Reset()
+
+	val, _ := ns.CacheStats.TotalHits.Float64()
+	e.cacheTotalHits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalMisses(ns nitroResponse) {
+	e.cacheTotalMisses.Reset()
+
+	val, _ := ns.CacheStats.TotalMisses.Float64()
+	e.cacheTotalMisses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalRequests(ns nitroResponse) {
+	e.cacheTotalRequests.Reset()
+
+	val, _ := ns.CacheStats.TotalRequests.Float64()
+	e.cacheTotalRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotal304Hits(ns nitroResponse) {
+	e.cacheTotal304Hits.Reset()
+
+	val, _ := ns.CacheStats.Total304Hits.Float64()
+	e.cacheTotal304Hits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalStoreableMisses(ns nitroResponse) {
+	e.cacheTotalStoreableMisses.Reset()
+
+	val, _ := ns.CacheStats.TotalStoreableMisses.Float64()
+	e.cacheTotalStoreableMisses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalNonStoreableMisses(ns nitroResponse) {
+	e.cacheTotalNonStoreableMisses.Reset()
+
+	val, _ := ns.CacheStats.TotalNonStoreableMisses.Float64()
+	e.cacheTotalNonStoreableMisses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalFlashCacheHits(ns nitroResponse) {
+	e.cacheTotalFlashCacheHits.Reset()
+
+	val, _ := ns.CacheStats.FlashCacheHits.Float64()
+	e.cacheTotalFlashCacheHits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheTotalFlashCacheMisses(ns nitroResponse) {
+	e.cacheTotalFlashCacheMisses.Reset()
+
+	val, _ := ns.CacheStats.FlashCacheMisses.Float64()
+	e.cacheTotalFlashCacheMisses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheServedBytes(ns nitroResponse) {
+	e.cacheServedBytes.Reset()
+
+	val, _ := ns.CacheStats.BytesServed.Float64()
+	e.cacheServedBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheOriginBandwidthSaved(ns nitroResponse) {
+	e.cacheOriginBandwidthSaved.Reset()
+
+	e.cacheOriginBandwidthSaved.WithLabelValues(e.nsInstance).Set(ns.CacheStats.PercentOriginBandwidthSaved)
+}
+
+func (e *Exporter) collectCacheCachedObjects(ns nitroResponse) {
+	e.cacheCachedObjects.Reset()
+
+	val, _ := ns.CacheStats.NumCached.Float64()
+	e.cacheCachedObjects.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCacheMemoryUsed(ns nitroResponse) {
+	e.cacheMemoryUsed.Reset()
+
+	val, _ := ns.CacheStats.UtilizedMemoryKB.Float64()
+	// Value is in kilobytes. Convert to base unit of bytes
+	e.cacheMemoryUsed.WithLabelValues(e.nsInstance).Set(val * 1024)
+}
+
+func (e *Exporter) collectCacheMemoryMax(ns nitroResponse) {
+	e.cacheMemoryMax.Reset()
+
+	val, _ := ns.CacheStats.MaxMemoryKB.Float64()
+	// Value is in kilobytes. Convert to base unit of bytes
+	e.cacheMemoryMax.WithLabelValues(e.nsInstance).Set(val * 1024)
+}
+
+func (e *Exporter) collectCacheContentGroupMemoryUsed(ns nitroResponse) {
+	e.cacheContentGroupMemoryUsed.Reset()
+
+	for _, cg := range ns.CacheContentGroups {
+		val, _ := cg.MemUsage.Float64()
+		// Value is in kilobytes. Convert to base unit of bytes
+		e.cacheContentGroupMemoryUsed.WithLabelValues(e.nsInstance, cg.Name).Set(val * 1024)
+	}
+}
+
+func (e *Exporter) collectCacheContentGroupCachedObjects(ns nitroResponse) {
+	e.cacheContentGroupCachedObjects.Reset()
+
+	for _, cg := range ns.CacheContentGroups {
+		val, _ := cg.CacheCells.Float64()
+		e.cacheContentGroupCachedObjects.WithLabelValues(e.nsInstance, cg.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectCacheContentGroupHits(ns nitroResponse) {
+	e.cacheContentGroupHits.Reset()
+
+	for _, cg := range ns.CacheContentGroups {
+		val, _ := cg.Hits.Float64()
+		e.cacheContentGroupHits.WithLabelValues(e.nsInstance, cg.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectCacheContentGroupMisses(ns nitroResponse) {
+	e.cacheContentGroupMisses.Reset()
+
+	for _, cg := range ns.CacheContentGroups {
+		val, _ := cg.Misses.Float64()
+		e.cacheContentGroupMisses.WithLabelValues(e.nsInstance, cg.Name).Set(val)
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const channelsSubsystem = "channel"
+
+var channelsLabels = []string{
+	netscalerInstance,
+	`channel`,
+}
+
+var channelsMemberLabels = []string{
+	netscalerInstance,
+	`channel`,
+	`interface`,
+}
+
+// lacpState converts a LACP state flag to a number.  Nitro reports NS (not set) when the flag is off
+func lacpState(state string) float64 {
+	if state == "" || state == "NS" {
+		return 0.0
+	}
+	return 1.0
+}
+
+var (
+	channelsMembers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "members",
+			Help:      "Number of interfaces bound to the link aggregation channel",
+		},
+		channelsLabels,
+	)
+
+	channelsState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "state",
+			Help:      "Current state of the link aggregation channel. 0 = DOWN, 1 = UP, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		channelsLabels,
+	)
+
+	channelsMemberState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "member_state",
+			Help:      "Current link state of the member interface. 0 = DOWN, 1 = UP, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		channelsMemberLabels,
+	)
+
+	channelsMemberLACPActorInSync = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "member_lacp_actor_in_sync",
+			Help:      "Whether the NetScaler side of the LACP member link is in sync. 0 = NO, 1 = YES",
+		},
+		channelsMemberLabels,
+	)
+
+	channelsMemberLACPPartnerInSync = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "member_lacp_partner_in_sync",
+			Help:      "Whether the partner side of the LACP member link is in sync. 0 = NO, 1 = YES",
+		},
+		channelsMemberLabels,
+	)
+
+	channelsMemberLACPPartnerCollecting = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "member_lacp_partner_collecting",
+			Help:      "Whether the partner is collecting frames on the LACP member link. 0 = NO, 1 = YES",
+		},
+		channelsMemberLabels,
+	)
+
+	channelsMemberLACPPartnerDistributing = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: channelsSubsystem,
+			Name:      "member_lacp_partner_distributing",
+			Help:      "Whether the partner is distributing frames on the LACP member link. 0 = NO, 1 = YES",
+		},
+		channelsMemberLabels,
+	)
+)
+
+// channelStats returns the interface stats of the link aggregation channels, keyed by channel
+func (ns nitroResponse) channelStats(channels nitroResponse) map[string]interfaceStats {
+	stats := make(map[string]interfaceStats)
+	for _, channel := range channels.Channels {
+		for _, iface := range ns.InterfaceStats {
+			if iface.ID == channel.ID {
+				stats[channel.ID] = iface
+			}
+		}
+	}
+	return stats
+}
+
+// lacpMember is a member interface of a channel running LACP
+type lacpMember struct {
+	channel string
+	iface   interfaces
+}
+
+// lacpMembers returns the member interfaces of the channels which run LACP
+func (ns nitroResponse) lacpMembers(channels nitroResponse) []lacpMember {
+	config := make(map[string]interfaces)
+	for _, iface := range ns.Interfaces {
+		config[iface.ID] = iface
+	}
+
+	var members []lacpMember
+	for _, channel := range channels.Channels {
+		for _, ifnum := range channel.IfNums {
+			iface, ok := config[ifnum]
+			// Static channels don't run LACP
+			if !ok || iface.LACPActorInSync == "" {
+				continue
+			}
+			members = append(members, lacpMember{channel: channel.ID, iface: iface})
+		}
+	}
+	return members
+}
+
+func (e *Exporter) collectChannelsMembers(channels nitroResponse) {
+	e.channelsMembers.Reset()
+
+	for _, channel := range channels.Channels {
+		e.channelsMembers.WithLabelValues(e.nsInstance, channel.ID).Set(float64(len(channel.IfNums)))
+	}
+}
+
+func (e *Exporter) collectChannelsState(channels nitroResponse, ns nitroResponse) {
+	e.channelsState.Reset()
+
+	for channel, iface := range ns.channelStats(channels) {
+		var state float64
+		switch iface.State {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		case `DISABLED`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+
+		e.channelsState.WithLabelValues(e.nsInstance, channel).Set(state)
+	}
+}
+
+func (e *Exporter) collectChannelsMemberState(channels nitroResponse, ns nitroResponse) {
+	e.channelsMemberState.Reset()
+
+	states := make(map[string]string)
+	for _, iface := range ns.InterfaceStats {
+		states[iface.ID] = iface.State
+	}
+
+	for _, channel := range channels.Channels {
+		for _, ifnum := range channel.IfNums {
+			var state float64
+			switch states[ifnum] {
+			case `DOWN`:
+				state = 0.0
+			case `UP`:
+				state = 1.0
+			case `DISABLED`:
+				state = 2.0
+			default:
+				state = 3.0
+			}
+
+			e.channelsMemberState.WithLabelValues(e.nsInstance, channel.ID, ifnum).Set(state)
+		}
+	}
+}
+
+func (e *Exporter) collectChannelsMemberLACPActorInSync(channels nitroResponse, ns nitroResponse) {
+	e.channelsMemberLACPActorInSync.Reset()
+
+	for _, m := range ns.lacpMembers(channels) {
+		e.channelsMemberLACPActorInSync.WithLabelValues(e.nsInstance, m.channel, m.iface.ID).Set(lacpState(m.iface.LACPActorInSync))
+	}
+}
+
+func (e *Exporter) collectChannelsMemberLACPPartnerInSync(channels nitroResponse, ns nitroResponse) {
+	e.channelsMemberLACPPartnerInSync.Reset()
+
+	for _, m := range ns.lacpMembers(channels) {
+		e.channelsMemberLACPPartnerInSync.WithLabelValues(e.nsInstance, m.channel, m.iface.ID).Set(lacpState(m.iface.LACPPartnerInSync))
+	}
+}
+
+func (e *Exporter) collectChannelsMemberLACPPartnerCollecting(channels nitroResponse, ns nitroResponse) {
+	e.channelsMemberLACPPartnerCollecting.Reset()
+
+	for _, m := range ns.lacpMembers(channels) {
+		e.channelsMemberLACPPartnerCollecting.WithLabelValues(e.nsInstance, m.channel, m.iface.ID).Set(lacpState(m.iface.LACPPartnerCollecting))
+	}
+}
+
+func (e *Exporter) collectChannelsMemberLACPPartnerDistributing(channels nitroResponse, ns nitroResponse) {
+	e.channelsMemberLACPPartnerDistributing.Reset()
+
+	for _, m := range ns.lacpMembers(channels) {
+		e.channelsMemberLACPPartnerDistributing.WithLabelValues(e.nsInstance, m.channel, m.iface.ID).Set(lacpState(m.iface.LACPPartnerDistributing))
+	}
+}
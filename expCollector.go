@@ -17,8 +17,27 @@ const (
 	controlSize = 50
 )
 
-// Collect is initiated by the Prometheus handler and gathers the metrics
+// Collect is initiated by the Prometheus handler and gathers the metrics.  When scraping an admin partition,
+// every metric is labelled with the partition
 func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	if e.partition == "" {
+		e.collect(ch)
+		return
+	}
+
+	metrics := make(chan prometheus.Metric)
+	go func() {
+		e.collect(metrics)
+		close(metrics)
+	}()
+
+	for m := range metrics {
+		ch <- partitionMetric{Metric: m, partition: e.partition}
+	}
+}
+
+// collect gathers the metrics from the NetScaler
+func (e *Exporter) collect(ch chan<- prometheus.Metric) {
 
 	nsClient, err := netscaler.NewNitroClient(e.url, e.username, e.password, e.ignoreCert)
 	if err != nil {
@@ -33,7 +52,27 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	nslicense, err := netscaler.GetNSLicense(nsClient, "")
+	if e.partition != "" {
+		err = netscaler.SwitchPartition(nsClient, e.partition)
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+			ch <- prometheus.NewInvalidMetric(prometheus.NewDesc("citrix_netscaler_exporter_error", "Error switching to partition", nil, nil), err)
+
+			// The session was opened by Connect, so it has to be closed or it's left open on the NetScaler until it times out
+			err = netscaler.Disconnect(nsClient)
+			if err != nil {
+				level.Error(e.logger).Log("msg", err)
+			}
+			return
+		}
+	}
+
+	nslicense, err := getNSLicense(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	nscapacity, err := getNSCapacity(nsClient, "")
 	if err != nil {
 		level.Error(e.logger).Log("msg", err)
 	}
@@ -43,12 +82,47 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		level.Error(e.logger).Log("msg", err)
 	}
 
-	interfaces, err := netscaler.GetInterfaceStats(nsClient, "")
+	nsVersion, err := getNSVersion(nsClient, "")
 	if err != nil {
 		level.Error(e.logger).Log("msg", err)
 	}
 
-	virtualServers, err := netscaler.GetVirtualServerStats(nsClient, "")
+	nsHardware, err := getNSHardware(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	nsHostname, err := getNSHostname(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	nsconfig, err := getNSConfig(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	interfaces, err := getInterfaceStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	interfacesConfig, err := getInterfaces(nsClient, "attrs=id,ifalias,reqspeed,actspeed,actduplex,lacpactorinsync,lacppartnerinsync,lacppartnercollecting,lacppartnerdistributing")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	channels, err := getChannels(nsClient, "attrs=id,ifnum")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	vlans, err := getVLANStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	virtualServers, err := getVirtualServerStats(nsClient, "")
 	if err != nil {
 		level.Error(e.logger).Log("msg", err)
 	}
@@ -78,6 +152,251 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		level.Error(e.logger).Log("msg", err)
 	}
 
+	systemMemory, err := getSystemMemoryStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	system, err := getSystemStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	servers, err := getServers(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serverServiceBindings, err := getServerServiceBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serverServiceGroupBindings, err := getServerServiceGroupBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	monitors, err := getMonitors(nsClient, "attrs=monitorname,type")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serviceMonitorBindings, err := getServiceMonitorBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serviceGroupMonitorBindings, err := getServiceGroupMonitorBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serviceGroupMemberMonitorBindings, err := getServiceGroupMemberMonitorBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	cache, err := getCacheStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	cacheContentGroups, err := getCacheContentGroups(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	compression, err := getCompressionStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	compressionPolicies, err := getCompressionPolicies(nsClient, "attrs=name,resaction,hits")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	compressionActions, err := getCompressionActions(nsClient, "attrs=name,cmptype")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	appfw, err := getAppFWStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	appfwProfiles, err := getAppFWProfileStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	// Bot management was introduced in 13.0, and older firmware returns an error for its resources rather than nothing
+	botManagement := botManagementSupported(nsVersion.NSVersion.Version)
+
+	var policies nitroResponse
+	for _, policyType := range policyTypes {
+		if policyType == "bot" && !botManagement {
+			continue
+		}
+		err = getPolicies(nsClient, policyType, &policies)
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+		}
+	}
+
+	protocolTCP, err := getProtocolTCPStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	protocolHTTP, err := getProtocolHTTPStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	protocolIP, err := getProtocolIPStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	protocolUDP, err := getProtocolUDPStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	protocolICMP, err := getProtocolICMPStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	dns, err := getDNSStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	gslbSites, err := getGSLBSiteStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	gslbSitesConfig, err := getGSLBSites(nsClient, "attrs=sitename,sitestate")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	aaa, err := getAAAStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	authenticationVirtualServers, err := getAuthenticationVirtualServerStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	vpn, err := getVPNStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	partitions, err := getPartitionStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	trafficDomains, err := getTrafficDomains(nsClient, "attrs=td,aliasname,state")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	e.trafficDomainMembers = make(map[string]map[string]string)
+	for _, configType := range trafficDomainTypes {
+		members, err := getTrafficDomainMembers(nsClient, configType)
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+		}
+		e.trafficDomainMembers[configType] = members
+	}
+
+	virtualServersConfig, err := getVirtualServers(nsClient, "attrs=name,ipv46,port,servicetype,lbmethod,persistencetype,backupvserver,comment")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	csVirtualServersConfig, err := getCSVirtualServers(nsClient, "attrs=name,ipv46,port,servicetype,persistencetype,backupvserver,comment")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	gslbVirtualServersConfig, err := getGSLBVirtualServers(nsClient, "attrs=name,servicetype,lbmethod,persistencetype,backupvserver,comment")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	servicesConfig, err := getServices(nsClient, "attrs=name,ipaddress,port,servername,maxclient,svrstate")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	virtualServerServiceBindings, err := getVirtualServerServiceBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serviceGroupsConfig, err := getServiceGroups(nsClient, "attrs=servicegroupname,maxclient")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	serviceGroupMemberBindings, err := getServiceGroupMemberBindings(nsClient, "bulkbindings=yes")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	// The persistence session and connection tables can hold millions of entries, so they're only fetched when asked for
+	var persistentSessions, connectionTable nitroResponse
+	if *sessionsFlg {
+		persistentSessions, err = getPersistentSessions(nsClient, "attrs=vservername,typestring")
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+		}
+
+		connectionTable, err = getConnections(nsClient, "attrs=entityname,state")
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+		}
+	}
+
+	limitIdentifierStats, err := getLimitIdentifierStats(nsClient, "")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	limitIdentifiers, err := getLimitIdentifiers(nsClient, "attrs=limitidentifier,threshold,mode")
+	if err != nil {
+		level.Error(e.logger).Log("msg", err)
+	}
+
+	var limitSessions nitroResponse
+	for _, l := range limitIdentifiers.LimitIdentifiers {
+		sessions, err := getLimitSessions(nsClient, l.LimitIdentifier)
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+			continue
+		}
+		limitSessions.LimitSessions = append(limitSessions.LimitSessions, sessions.LimitSessions...)
+	}
+
+	var botProfiles nitroResponse
+	if botManagement {
+		botProfiles, err = getBotProfileStats(nsClient, "")
+		if err != nil {
+			level.Error(e.logger).Log("msg", err)
+		}
+	}
+
 	fltModelID, _ := strconv.ParseFloat(nslicense.NSLicense.ModelID, 64)
 
 	fltTotRxMB, _ := strconv.ParseFloat(ns.NSStats.TotalReceivedMB, 64)
@@ -148,6 +467,36 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		tcpCurrentServerConnectionsEstablished, prometheus.GaugeValue, fltTCPCurrentServerConnectionsEstablished, e.nsInstance,
 	)
 
+	e.collectBuildInfo(nsVersion, nsHardware, nsHostname)
+	e.buildInfo.Collect(ch)
+
+	e.collectUptimeSeconds(system, nsconfig)
+	e.uptimeSeconds.Collect(ch)
+
+	e.collectBootTimeSeconds(system, nsconfig)
+	e.bootTimeSeconds.Collect(ch)
+
+	e.collectLicenseFeatureEnabled(nslicense)
+	e.licenseFeatureEnabled.Collect(ch)
+
+	e.collectLicenseExpiryDays(nslicense)
+	e.licenseExpiryDays.Collect(ch)
+
+	e.collectLicenseThroughput(nscapacity)
+	e.licenseThroughput.Collect(ch)
+
+	e.collectLicenseActualThroughput(nscapacity)
+	e.licenseActualThroughput.Collect(ch)
+
+	e.collectConfigUnsavedChanges(nsconfig)
+	e.configUnsavedChanges.Collect(ch)
+
+	e.collectConfigLastSaveTimestamp(nsconfig)
+	e.configLastSaveTimestamp.Collect(ch)
+
+	e.collectConfigLastChangeTimestamp(nsconfig)
+	e.configLastChangeTimestamp.Collect(ch)
+
 	e.collectInterfacesRxBytes(interfaces)
 	e.interfacesRxBytes.Collect(ch)
 
@@ -169,6 +518,87 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 	e.collectInterfacesErrorPacketsRx(interfaces)
 	e.interfacesErrorPacketsRx.Collect(ch)
 
+	e.collectInterfacesErrorPacketsTx(interfaces)
+	e.interfacesErrorPacketsTx.Collect(ch)
+
+	e.collectInterfacesDroppedPacketsRx(interfaces)
+	e.interfacesDroppedPacketsRx.Collect(ch)
+
+	e.collectInterfacesDroppedPacketsTx(interfaces)
+	e.interfacesDroppedPacketsTx.Collect(ch)
+
+	e.collectInterfacesMulticastPackets(interfaces)
+	e.interfacesMulticastPackets.Collect(ch)
+
+	e.collectInterfacesLinkHangs(interfaces)
+	e.interfacesLinkHangs.Collect(ch)
+
+	e.collectInterfacesNICRxStalls(interfaces)
+	e.interfacesNICRxStalls.Collect(ch)
+
+	e.collectInterfacesNICTxStalls(interfaces)
+	e.interfacesNICTxStalls.Collect(ch)
+
+	e.collectInterfacesLinkReinits(interfaces)
+	e.interfacesLinkReinits.Collect(ch)
+
+	e.collectInterfacesLinkUptime(interfaces)
+	e.interfacesLinkUptime.Collect(ch)
+
+	e.collectInterfacesLinkState(interfaces)
+	e.interfacesLinkState.Collect(ch)
+
+	e.collectInterfacesActualSpeed(interfacesConfig)
+	e.interfacesActualSpeed.Collect(ch)
+
+	e.collectInterfacesRequestedSpeed(interfacesConfig)
+	e.interfacesRequestedSpeed.Collect(ch)
+
+	e.collectInterfacesDuplex(interfacesConfig)
+	e.interfacesDuplex.Collect(ch)
+
+	e.collectInterfacesChannelInfo(interfacesConfig, channels)
+	e.interfacesChannelInfo.Collect(ch)
+
+	e.collectChannelsMembers(channels)
+	e.channelsMembers.Collect(ch)
+
+	e.collectChannelsState(channels, interfaces)
+	e.channelsState.Collect(ch)
+
+	e.collectChannelsMemberState(channels, interfaces)
+	e.channelsMemberState.Collect(ch)
+
+	e.collectChannelsMemberLACPActorInSync(channels, interfacesConfig)
+	e.channelsMemberLACPActorInSync.Collect(ch)
+
+	e.collectChannelsMemberLACPPartnerInSync(channels, interfacesConfig)
+	e.channelsMemberLACPPartnerInSync.Collect(ch)
+
+	e.collectChannelsMemberLACPPartnerCollecting(channels, interfacesConfig)
+	e.channelsMemberLACPPartnerCollecting.Collect(ch)
+
+	e.collectChannelsMemberLACPPartnerDistributing(channels, interfacesConfig)
+	e.channelsMemberLACPPartnerDistributing.Collect(ch)
+
+	e.collectVLANsRxPackets(vlans)
+	e.vlansRxPackets.Collect(ch)
+
+	e.collectVLANsRxBytes(vlans)
+	e.vlansRxBytes.Collect(ch)
+
+	e.collectVLANsTxPackets(vlans)
+	e.vlansTxPackets.Collect(ch)
+
+	e.collectVLANsTxBytes(vlans)
+	e.vlansTxBytes.Collect(ch)
+
+	e.collectVLANsDroppedPackets(vlans)
+	e.vlansDroppedPackets.Collect(ch)
+
+	e.collectVLANsBroadcastPackets(vlans)
+	e.vlansBroadcastPackets.Collect(ch)
+
 	e.collectVirtualServerWaitingRequests(virtualServers)
 	e.virtualServersWaitingRequests.Collect(ch)
 
@@ -205,6 +635,39 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 	e.collectVirtualServerState(virtualServers)
 	e.virtualServersState.Collect(ch)
 
+	e.collectVirtualServerSurgeCount(virtualServers)
+	e.virtualServersSurgeCount.Collect(ch)
+
+	e.collectVirtualServerTotalSpillovers(virtualServers)
+	e.virtualServersTotalSpillovers.Collect(ch)
+
+	e.collectVirtualServerTotalVServerDownBackupHits(virtualServers)
+	e.virtualServersTotalVServerDownBackupHits.Collect(ch)
+
+	e.collectVirtualServerAvgClientTTLB(virtualServers)
+	e.virtualServersAvgClientTTLB.Collect(ch)
+
+	e.collectVirtualServerNumberInvalidRequestResponse(virtualServers)
+	e.virtualServersNumberInvalidRequestResponse.Collect(ch)
+
+	e.collectVirtualServerNumberInvalidRequestResponseDropped(virtualServers)
+	e.virtualServersNumberInvalidRequestResponseDropped.Collect(ch)
+
+	e.collectVirtualServerTotalPacketsReceived(virtualServers)
+	e.virtualServersTotalPacketsReceived.Collect(ch)
+
+	e.collectVirtualServerTotalPacketsSent(virtualServers)
+	e.virtualServersTotalPacketsSent.Collect(ch)
+
+	e.collectVirtualServerEstablishedConnections(virtualServers)
+	e.virtualServersEstablishedConnections.Collect(ch)
+
+	e.collectVirtualServerDeferredRequests(virtualServers)
+	e.virtualServersDeferredRequests.Collect(ch)
+
+	e.collectVirtualServerTotalServerBusyErrors(virtualServers)
+	e.virtualServersTotalServerBusyErrors.Collect(ch)
+
 	e.collectServicesThroughput(services)
 	e.servicesThroughput.Collect(ch)
 
@@ -386,6 +849,669 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 	e.collectVPNVirtualServerState(vpnVirtualServers)
 	e.vpnVirtualServersState.Collect(ch)
 
+	e.collectSystemMemoryAllocatedPct(systemMemory)
+	e.systemMemoryAllocatedPct.Collect(ch)
+
+	e.collectSystemMemoryAllocatedBytes(systemMemory)
+	e.systemMemoryAllocatedBytes.Collect(ch)
+
+	e.collectSystemMemorySizeBytes(systemMemory)
+	e.systemMemorySizeBytes.Collect(ch)
+
+	e.collectSystemMemoryFreeBytes(systemMemory)
+	e.systemMemoryFreeBytes.Collect(ch)
+
+	e.collectSystemMemoryAllocationFailures(systemMemory)
+	e.systemMemoryAllocationFailures.Collect(ch)
+
+	e.collectHardwareTemperature(system)
+	e.hardwareTemperature.Collect(ch)
+
+	e.collectHardwareFanSpeed(system)
+	e.hardwareFanSpeed.Collect(ch)
+
+	e.collectHardwarePowerSupplyStatus(system)
+	e.hardwarePowerSupplyStatus.Collect(ch)
+
+	e.collectHardwareDiskUsage(system)
+	e.hardwareDiskUsage.Collect(ch)
+
+	e.collectHardwareDiskSize(system)
+	e.hardwareDiskSize.Collect(ch)
+
+	e.collectHardwareDiskAvailable(system)
+	e.hardwareDiskAvailable.Collect(ch)
+
+	e.collectServersState(servers)
+	e.serversState.Collect(ch)
+
+	e.collectServersDomainIPAddress(servers)
+	e.serversDomainIPAddress.Collect(ch)
+
+	e.collectServersBoundServices(servers, serverServiceBindings)
+	e.serversBoundServices.Collect(ch)
+
+	e.collectServersBoundServiceGroups(servers, serverServiceGroupBindings)
+	e.serversBoundServiceGroups.Collect(ch)
+
+	e.collectMonitorsServiceState(serviceMonitorBindings, monitors)
+	e.monitorsServiceState.Collect(ch)
+
+	e.collectMonitorsServiceResponseTime(serviceMonitorBindings, monitors)
+	e.monitorsServiceResponseTime.Collect(ch)
+
+	e.collectMonitorsServiceProbes(serviceMonitorBindings, monitors)
+	e.monitorsServiceProbes.Collect(ch)
+
+	e.collectMonitorsServiceFailedProbes(serviceMonitorBindings, monitors)
+	e.monitorsServiceFailedProbes.Collect(ch)
+
+	e.collectMonitorsServiceCurrentFailedProbes(serviceMonitorBindings, monitors)
+	e.monitorsServiceCurrentFailedProbes.Collect(ch)
+
+	e.collectMonitorsServiceLastResponse(serviceMonitorBindings, monitors)
+	e.monitorsServiceLastResponse.Collect(ch)
+
+	e.collectMonitorsServiceGroupState(serviceGroupMonitorBindings, monitors)
+	e.monitorsServiceGroupState.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberState(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberState.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberResponseTime(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberResponseTime.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberProbes(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberProbes.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberFailedProbes(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberFailedProbes.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberCurrentFailedProbes(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberCurrentFailedProbes.Collect(ch)
+
+	e.collectMonitorsServiceGroupMemberLastResponse(serviceGroupMemberMonitorBindings, monitors)
+	e.monitorsServiceGroupMemberLastResponse.Collect(ch)
+
+	e.collectCacheTotalHits(cache)
+	e.cacheTotalHits.Collect(ch)
+
+	e.collectCacheTotalMisses(cache)
+	e.cacheTotalMisses.Collect(ch)
+
+	e.collectCacheTotalRequests(cache)
+	e.cacheTotalRequests.Collect(ch)
+
+	e.collectCacheTotal304Hits(cache)
+	e.cacheTotal304Hits.Collect(ch)
+
+	e.collectCacheTotalStoreableMisses(cache)
+	e.cacheTotalStoreableMisses.Collect(ch)
+
+	e.collectCacheTotalNonStoreableMisses(cache)
+	e.cacheTotalNonStoreableMisses.Collect(ch)
+
+	e.collectCacheTotalFlashCacheHits(cache)
+	e.cacheTotalFlashCacheHits.Collect(ch)
+
+	e.collectCacheTotalFlashCacheMisses(cache)
+	e.cacheTotalFlashCacheMisses.Collect(ch)
+
+	e.collectCacheServedBytes(cache)
+	e.cacheServedBytes.Collect(ch)
+
+	e.collectCacheOriginBandwidthSaved(cache)
+	e.cacheOriginBandwidthSaved.Collect(ch)
+
+	e.collectCacheCachedObjects(cache)
+	e.cacheCachedObjects.Collect(ch)
+
+	e.collectCacheMemoryUsed(cache)
+	e.cacheMemoryUsed.Collect(ch)
+
+	e.collectCacheMemoryMax(cache)
+	e.cacheMemoryMax.Collect(ch)
+
+	e.collectCacheContentGroupMemoryUsed(cacheContentGroups)
+	e.cacheContentGroupMemoryUsed.Collect(ch)
+
+	e.collectCacheContentGroupCachedObjects(cacheContentGroups)
+	e.cacheContentGroupCachedObjects.Collect(ch)
+
+	e.collectCacheContentGroupHits(cacheContentGroups)
+	e.cacheContentGroupHits.Collect(ch)
+
+	e.collectCacheContentGroupMisses(cacheContentGroups)
+	e.cacheContentGroupMisses.Collect(ch)
+
+	e.collectCompressionHTTPTotalRequests(compression)
+	e.compressionHTTPTotalRequests.Collect(ch)
+
+	e.collectCompressionHTTPTotalRxBytes(compression)
+	e.compressionHTTPTotalRxBytes.Collect(ch)
+
+	e.collectCompressionHTTPTotalTxBytes(compression)
+	e.compressionHTTPTotalTxBytes.Collect(ch)
+
+	e.collectCompressionHTTPTotalRxPackets(compression)
+	e.compressionHTTPTotalRxPackets.Collect(ch)
+
+	e.collectCompressionHTTPTotalTxPackets(compression)
+	e.compressionHTTPTotalTxPackets.Collect(ch)
+
+	e.collectCompressionHTTPRatio(compression)
+	e.compressionHTTPRatio.Collect(ch)
+
+	e.collectCompressionHTTPBandwidthSaving(compression)
+	e.compressionHTTPBandwidthSaving.Collect(ch)
+
+	e.collectCompressionTCPTotalRxBytes(compression)
+	e.compressionTCPTotalRxBytes.Collect(ch)
+
+	e.collectCompressionTCPTotalTxBytes(compression)
+	e.compressionTCPTotalTxBytes.Collect(ch)
+
+	e.collectCompressionTCPRatio(compression)
+	e.compressionTCPRatio.Collect(ch)
+
+	e.collectCompressionTCPBandwidthSaving(compression)
+	e.compressionTCPBandwidthSaving.Collect(ch)
+
+	e.collectCompressionPolicyHits(compressionPolicies, compressionActions)
+	e.compressionPolicyHits.Collect(ch)
+
+	e.collectAppFWRequests(appfw)
+	e.appfwRequests.Collect(ch)
+
+	e.collectAppFWResponses(appfw)
+	e.appfwResponses.Collect(ch)
+
+	e.collectAppFWRequestBytes(appfw)
+	e.appfwRequestBytes.Collect(ch)
+
+	e.collectAppFWResponseBytes(appfw)
+	e.appfwResponseBytes.Collect(ch)
+
+	e.collectAppFWAborts(appfw)
+	e.appfwAborts.Collect(ch)
+
+	e.collectAppFWRedirects(appfw)
+	e.appfwRedirects.Collect(ch)
+
+	e.collectAppFWViolations(appfw)
+	e.appfwViolations.Collect(ch)
+
+	e.collectAppFWCheckViolations(appfw)
+	e.appfwCheckViolations.Collect(ch)
+
+	e.collectAppFWProfileRequests(appfwProfiles)
+	e.appfwProfileRequests.Collect(ch)
+
+	e.collectAppFWProfileResponses(appfwProfiles)
+	e.appfwProfileResponses.Collect(ch)
+
+	e.collectAppFWProfileAborts(appfwProfiles)
+	e.appfwProfileAborts.Collect(ch)
+
+	e.collectAppFWProfileRedirects(appfwProfiles)
+	e.appfwProfileRedirects.Collect(ch)
+
+	e.collectAppFWProfileViolations(appfwProfiles)
+	e.appfwProfileViolations.Collect(ch)
+
+	e.collectAppFWProfileCheckViolations(appfwProfiles)
+	e.appfwProfileCheckViolations.Collect(ch)
+
+	e.collectPolicyHits(policies)
+	e.policyHits.Collect(ch)
+
+	e.collectPolicyUndefHits(policies)
+	e.policyUndefHits.Collect(ch)
+
+	e.collectPolicyBindingInfo(policies)
+	e.policyBindingInfo.Collect(ch)
+
+	e.collectProtocolTCPRxPackets(protocolTCP)
+	e.protocolTCPRxPackets.Collect(ch)
+
+	e.collectProtocolTCPRxBytes(protocolTCP)
+	e.protocolTCPRxBytes.Collect(ch)
+
+	e.collectProtocolTCPTxPackets(protocolTCP)
+	e.protocolTCPTxPackets.Collect(ch)
+
+	e.collectProtocolTCPTxBytes(protocolTCP)
+	e.protocolTCPTxBytes.Collect(ch)
+
+	e.collectProtocolTCPClientConnections(protocolTCP)
+	e.protocolTCPClientConnections.Collect(ch)
+
+	e.collectProtocolTCPClientConnectionsEstablished(protocolTCP)
+	e.protocolTCPClientConnectionsEstablished.Collect(ch)
+
+	e.collectProtocolTCPServerConnections(protocolTCP)
+	e.protocolTCPServerConnections.Collect(ch)
+
+	e.collectProtocolTCPServerConnectionsEstablished(protocolTCP)
+	e.protocolTCPServerConnectionsEstablished.Collect(ch)
+
+	e.collectProtocolTCPSurgeQueueLength(protocolTCP)
+	e.protocolTCPSurgeQueueLength.Collect(ch)
+
+	e.collectProtocolTCPSpareConnections(protocolTCP)
+	e.protocolTCPSpareConnections.Collect(ch)
+
+	e.collectProtocolTCPSyn(protocolTCP)
+	e.protocolTCPSyn.Collect(ch)
+
+	e.collectProtocolTCPSynProbes(protocolTCP)
+	e.protocolTCPSynProbes.Collect(ch)
+
+	e.collectProtocolTCPSynHeld(protocolTCP)
+	e.protocolTCPSynHeld.Collect(ch)
+
+	e.collectProtocolTCPSynDroppedCongestion(protocolTCP)
+	e.protocolTCPSynDroppedCongestion.Collect(ch)
+
+	e.collectProtocolTCPSynRetries(protocolTCP)
+	e.protocolTCPSynRetries.Collect(ch)
+
+	e.collectProtocolTCPSynGiveUps(protocolTCP)
+	e.protocolTCPSynGiveUps.Collect(ch)
+
+	e.collectProtocolTCPSynCookieSeqRejects(protocolTCP)
+	e.protocolTCPSynCookieSeqRejects.Collect(ch)
+
+	e.collectProtocolTCPSynCookieSignatureRejects(protocolTCP)
+	e.protocolTCPSynCookieSignatureRejects.Collect(ch)
+
+	e.collectProtocolTCPSynCookieSeqDrops(protocolTCP)
+	e.protocolTCPSynCookieSeqDrops.Collect(ch)
+
+	e.collectProtocolTCPSynCookieMSSRejects(protocolTCP)
+	e.protocolTCPSynCookieMSSRejects.Collect(ch)
+
+	e.collectProtocolTCPRetransmits(protocolTCP)
+	e.protocolTCPRetransmits.Collect(ch)
+
+	e.collectProtocolTCPFirstRetransmits(protocolTCP)
+	e.protocolTCPFirstRetransmits.Collect(ch)
+
+	e.collectProtocolTCPClientRetransmits(protocolTCP)
+	e.protocolTCPClientRetransmits.Collect(ch)
+
+	e.collectProtocolTCPServerRetransmits(protocolTCP)
+	e.protocolTCPServerRetransmits.Collect(ch)
+
+	e.collectProtocolTCPRetransmitGiveUps(protocolTCP)
+	e.protocolTCPRetransmitGiveUps.Collect(ch)
+
+	e.collectProtocolTCPRstReceived(protocolTCP)
+	e.protocolTCPRstReceived.Collect(ch)
+
+	e.collectProtocolTCPRstSent(protocolTCP)
+	e.protocolTCPRstSent.Collect(ch)
+
+	e.collectProtocolTCPRstOutOfWindow(protocolTCP)
+	e.protocolTCPRstOutOfWindow.Collect(ch)
+
+	e.collectProtocolTCPRstNonEstablished(protocolTCP)
+	e.protocolTCPRstNonEstablished.Collect(ch)
+
+	e.collectProtocolTCPBadChecksums(protocolTCP)
+	e.protocolTCPBadChecksums.Collect(ch)
+
+	e.collectProtocolTCPStrayPackets(protocolTCP)
+	e.protocolTCPStrayPackets.Collect(ch)
+
+	e.collectProtocolTCPClientOutOfOrder(protocolTCP)
+	e.protocolTCPClientOutOfOrder.Collect(ch)
+
+	e.collectProtocolTCPServerOutOfOrder(protocolTCP)
+	e.protocolTCPServerOutOfOrder.Collect(ch)
+
+	e.collectProtocolHTTPRequests(protocolHTTP)
+	e.protocolHTTPRequests.Collect(ch)
+
+	e.collectProtocolHTTPResponses(protocolHTTP)
+	e.protocolHTTPResponses.Collect(ch)
+
+	e.collectProtocolHTTPGetRequests(protocolHTTP)
+	e.protocolHTTPGetRequests.Collect(ch)
+
+	e.collectProtocolHTTPPostRequests(protocolHTTP)
+	e.protocolHTTPPostRequests.Collect(ch)
+
+	e.collectProtocolHTTPOtherRequests(protocolHTTP)
+	e.protocolHTTPOtherRequests.Collect(ch)
+
+	e.collectProtocolHTTPRequestBytes(protocolHTTP)
+	e.protocolHTTPRequestBytes.Collect(ch)
+
+	e.collectProtocolHTTPResponseBytes(protocolHTTP)
+	e.protocolHTTPResponseBytes.Collect(ch)
+
+	e.collectProtocolHTTPHTTP10Requests(protocolHTTP)
+	e.protocolHTTPHTTP10Requests.Collect(ch)
+
+	e.collectProtocolHTTPHTTP11Requests(protocolHTTP)
+	e.protocolHTTPHTTP11Requests.Collect(ch)
+
+	e.collectProtocolHTTPChunkedRequests(protocolHTTP)
+	e.protocolHTTPChunkedRequests.Collect(ch)
+
+	e.collectProtocolHTTPChunkedResponses(protocolHTTP)
+	e.protocolHTTPChunkedResponses.Collect(ch)
+
+	e.collectProtocolHTTPIncompleteRequests(protocolHTTP)
+	e.protocolHTTPIncompleteRequests.Collect(ch)
+
+	e.collectProtocolHTTPIncompleteResponses(protocolHTTP)
+	e.protocolHTTPIncompleteResponses.Collect(ch)
+
+	e.collectProtocolHTTPIncompleteHeaders(protocolHTTP)
+	e.protocolHTTPIncompleteHeaders.Collect(ch)
+
+	e.collectProtocolHTTPLargeContent(protocolHTTP)
+	e.protocolHTTPLargeContent.Collect(ch)
+
+	e.collectProtocolHTTPLargeChunk(protocolHTTP)
+	e.protocolHTTPLargeChunk.Collect(ch)
+
+	e.collectProtocolHTTPInvalidContentLength(protocolHTTP)
+	e.protocolHTTPInvalidContentLength.Collect(ch)
+
+	e.collectProtocolHTTPServerBusy(protocolHTTP)
+	e.protocolHTTPServerBusy.Collect(ch)
+
+	e.collectProtocolIPRxPackets(protocolIP)
+	e.protocolIPRxPackets.Collect(ch)
+
+	e.collectProtocolIPRxBytes(protocolIP)
+	e.protocolIPRxBytes.Collect(ch)
+
+	e.collectProtocolIPTxPackets(protocolIP)
+	e.protocolIPTxPackets.Collect(ch)
+
+	e.collectProtocolIPTxBytes(protocolIP)
+	e.protocolIPTxBytes.Collect(ch)
+
+	e.collectProtocolIPFragments(protocolIP)
+	e.protocolIPFragments.Collect(ch)
+
+	e.collectProtocolIPReassemblyAttempts(protocolIP)
+	e.protocolIPReassemblyAttempts.Collect(ch)
+
+	e.collectProtocolIPReassembled(protocolIP)
+	e.protocolIPReassembled.Collect(ch)
+
+	e.collectProtocolIPReassemblyFailures(protocolIP)
+	e.protocolIPReassemblyFailures.Collect(ch)
+
+	e.collectProtocolIPTooBig(protocolIP)
+	e.protocolIPTooBig.Collect(ch)
+
+	e.collectProtocolIPDuplicateFragments(protocolIP)
+	e.protocolIPDuplicateFragments.Collect(ch)
+
+	e.collectProtocolIPOutOfOrderFragments(protocolIP)
+	e.protocolIPOutOfOrderFragments.Collect(ch)
+
+	e.collectProtocolIPZeroLengthFragments(protocolIP)
+	e.protocolIPZeroLengthFragments.Collect(ch)
+
+	e.collectProtocolIPBadChecksums(protocolIP)
+	e.protocolIPBadChecksums.Collect(ch)
+
+	e.collectProtocolIPTTLExpired(protocolIP)
+	e.protocolIPTTLExpired.Collect(ch)
+
+	e.collectProtocolIPAddressLookups(protocolIP)
+	e.protocolIPAddressLookups.Collect(ch)
+
+	e.collectProtocolIPAddressLookupFailures(protocolIP)
+	e.protocolIPAddressLookupFailures.Collect(ch)
+
+	e.collectProtocolUDPRxPackets(protocolUDP)
+	e.protocolUDPRxPackets.Collect(ch)
+
+	e.collectProtocolUDPRxBytes(protocolUDP)
+	e.protocolUDPRxBytes.Collect(ch)
+
+	e.collectProtocolUDPTxPackets(protocolUDP)
+	e.protocolUDPTxPackets.Collect(ch)
+
+	e.collectProtocolUDPTxBytes(protocolUDP)
+	e.protocolUDPTxBytes.Collect(ch)
+
+	e.collectProtocolUDPUnknownServicePackets(protocolUDP)
+	e.protocolUDPUnknownServicePackets.Collect(ch)
+
+	e.collectProtocolUDPBadChecksums(protocolUDP)
+	e.protocolUDPBadChecksums.Collect(ch)
+
+	e.collectProtocolUDPRateThresholdExceeded(protocolUDP)
+	e.protocolUDPRateThresholdExceeded.Collect(ch)
+
+	e.collectProtocolICMPRxPackets(protocolICMP)
+	e.protocolICMPRxPackets.Collect(ch)
+
+	e.collectProtocolICMPRxBytes(protocolICMP)
+	e.protocolICMPRxBytes.Collect(ch)
+
+	e.collectProtocolICMPTxPackets(protocolICMP)
+	e.protocolICMPTxPackets.Collect(ch)
+
+	e.collectProtocolICMPTxBytes(protocolICMP)
+	e.protocolICMPTxBytes.Collect(ch)
+
+	e.collectProtocolICMPEchoRequestsReceived(protocolICMP)
+	e.protocolICMPEchoRequestsReceived.Collect(ch)
+
+	e.collectProtocolICMPEchoRepliesSent(protocolICMP)
+	e.protocolICMPEchoRepliesSent.Collect(ch)
+
+	e.collectProtocolICMPEchoRequestsSent(protocolICMP)
+	e.protocolICMPEchoRequestsSent.Collect(ch)
+
+	e.collectProtocolICMPEchoRepliesReceived(protocolICMP)
+	e.protocolICMPEchoRepliesReceived.Collect(ch)
+
+	e.collectProtocolICMPRateThresholdExceeded(protocolICMP)
+	e.protocolICMPRateThresholdExceeded.Collect(ch)
+
+	e.collectProtocolICMPBadChecksums(protocolICMP)
+	e.protocolICMPBadChecksums.Collect(ch)
+
+	e.collectDNSQueries(dns)
+	e.dnsQueries.Collect(ch)
+
+	e.collectDNSResponses(dns)
+	e.dnsResponses.Collect(ch)
+
+	e.collectDNSServerQueries(dns)
+	e.dnsServerQueries.Collect(ch)
+
+	e.collectDNSServerResponses(dns)
+	e.dnsServerResponses.Collect(ch)
+
+	e.collectDNSNXDomain(dns)
+	e.dnsNXDomain.Collect(ch)
+
+	e.collectDNSCacheHits(dns)
+	e.dnsCacheHits.Collect(ch)
+
+	e.collectDNSCacheMisses(dns)
+	e.dnsCacheMisses.Collect(ch)
+
+	e.collectDNSQueriesByType(dns)
+	e.dnsQueriesByType.Collect(ch)
+
+	e.collectGSLBSitesState(gslbSitesConfig)
+	e.gslbSitesState.Collect(ch)
+
+	e.collectGSLBSitesMetricExchangeStatus(gslbSites)
+	e.gslbSitesMetricExchangeStatus.Collect(ch)
+
+	e.collectGSLBSitesNetworkMetricExchangeStatus(gslbSites)
+	e.gslbSitesNetworkMetricExchangeStatus.Collect(ch)
+
+	e.collectGSLBSitesTotalRequests(gslbSites)
+	e.gslbSitesTotalRequests.Collect(ch)
+
+	e.collectGSLBSitesTotalResponses(gslbSites)
+	e.gslbSitesTotalResponses.Collect(ch)
+
+	e.collectGSLBSitesTotalRequestBytes(gslbSites)
+	e.gslbSitesTotalRequestBytes.Collect(ch)
+
+	e.collectGSLBSitesTotalResponseBytes(gslbSites)
+	e.gslbSitesTotalResponseBytes.Collect(ch)
+
+	e.collectGSLBSitesCurrentClientConns(gslbSites)
+	e.gslbSitesCurrentClientConns.Collect(ch)
+
+	e.collectGSLBSitesCurrentServerConns(gslbSites)
+	e.gslbSitesCurrentServerConns.Collect(ch)
+
+	e.collectAAACurrentSessions(aaa)
+	e.aaaCurrentSessions.Collect(ch)
+
+	e.collectAAACurrentICASessions(aaa)
+	e.aaaCurrentICASessions.Collect(ch)
+
+	e.collectAAACurrentICAConnections(aaa)
+	e.aaaCurrentICAConnections.Collect(ch)
+
+	e.collectAAACurrentICAOnlyConnections(aaa)
+	e.aaaCurrentICAOnlyConnections.Collect(ch)
+
+	e.collectAAAAuthSuccess(aaa)
+	e.aaaAuthSuccess.Collect(ch)
+
+	e.collectAAAAuthFailures(aaa)
+	e.aaaAuthFailures.Collect(ch)
+
+	e.collectAAASessionsTotal(aaa)
+	e.aaaSessionsTotal.Collect(ch)
+
+	e.collectAAASessionTimeouts(aaa)
+	e.aaaSessionTimeouts.Collect(ch)
+
+	e.collectAAACurrentTMSessions(aaa)
+	e.aaaCurrentTMSessions.Collect(ch)
+
+	e.collectAAATMSessionsTotal(aaa)
+	e.aaaTMSessionsTotal.Collect(ch)
+
+	e.collectAAAHTTPAuthSuccess(aaa)
+	e.aaaHTTPAuthSuccess.Collect(ch)
+
+	e.collectAAAHTTPAuthFailures(aaa)
+	e.aaaHTTPAuthFailures.Collect(ch)
+
+	e.collectAAANonHTTPAuthSuccess(aaa)
+	e.aaaNonHTTPAuthSuccess.Collect(ch)
+
+	e.collectAAANonHTTPAuthFailures(aaa)
+	e.aaaNonHTTPAuthFailures.Collect(ch)
+
+	e.collectAuthenticationVirtualServersState(authenticationVirtualServers)
+	e.authenticationVirtualServersState.Collect(ch)
+
+	e.collectAuthenticationVirtualServersTotalRequests(authenticationVirtualServers)
+	e.authenticationVirtualServersTotalRequests.Collect(ch)
+
+	e.collectAuthenticationVirtualServersTotalResponses(authenticationVirtualServers)
+	e.authenticationVirtualServersTotalResponses.Collect(ch)
+
+	e.collectAuthenticationVirtualServersTotalRequestBytes(authenticationVirtualServers)
+	e.authenticationVirtualServersTotalRequestBytes.Collect(ch)
+
+	e.collectAuthenticationVirtualServersTotalResponseBytes(authenticationVirtualServers)
+	e.authenticationVirtualServersTotalResponseBytes.Collect(ch)
+
+	e.collectVPNLoginPageHits(vpn)
+	e.vpnLoginPageHits.Collect(ch)
+
+	e.collectVPNICALicenseFailures(vpn)
+	e.vpnICALicenseFailures.Collect(ch)
+
+	e.collectVPNSTARequests(vpn)
+	e.vpnSTARequests.Collect(ch)
+
+	e.collectVPNSTAConnectionSuccess(vpn)
+	e.vpnSTAConnectionSuccess.Collect(ch)
+
+	e.collectVPNSTAConnectionFailures(vpn)
+	e.vpnSTAConnectionFailures.Collect(ch)
+
+	e.collectPartitionsCurrentBandwidth(partitions)
+	e.partitionsCurrentBandwidth.Collect(ch)
+
+	e.collectPartitionsMaxBandwidth(partitions)
+	e.partitionsMaxBandwidth.Collect(ch)
+
+	e.collectPartitionsCurrentConnections(partitions)
+	e.partitionsCurrentConnections.Collect(ch)
+
+	e.collectPartitionsMaxConnections(partitions)
+	e.partitionsMaxConnections.Collect(ch)
+
+	e.collectPartitionsMemoryUsage(partitions)
+	e.partitionsMemoryUsage.Collect(ch)
+
+	e.collectPartitionsMaxMemory(partitions)
+	e.partitionsMaxMemory.Collect(ch)
+
+	e.collectTrafficDomainsState(trafficDomains)
+	e.trafficDomainsState.Collect(ch)
+
+	e.collectTrafficDomainsEntities(servers)
+	e.trafficDomainsEntities.Collect(ch)
+
+	e.collectTrafficDomainsCurrentClientConnections(virtualServers)
+	e.trafficDomainsCurrentClientConnections.Collect(ch)
+
+	e.collectVirtualServerInfo(virtualServersConfig)
+	e.virtualServersInfo.Collect(ch)
+
+	e.collectCSVirtualServerInfo(csVirtualServersConfig)
+	e.csVirtualServersInfo.Collect(ch)
+
+	e.collectGSLBVirtualServerInfo(gslbVirtualServersConfig)
+	e.gslbVirtualServersInfo.Collect(ch)
+
+	e.collectServicesInfo(servicesConfig, virtualServerServiceBindings, serviceMonitorBindings)
+	e.servicesInfo.Collect(ch)
+
+	e.collectServiceGroupsMemberInfo(serviceGroupsConfig, serviceGroupMemberBindings, serviceGroupMonitorBindings)
+	e.serviceGroupsMemberInfo.Collect(ch)
+
+	e.collectPersistenceSessions(persistentSessions)
+	e.persistenceSessions.Collect(ch)
+
+	e.collectConnectionTableConnections(connectionTable, virtualServersConfig, csVirtualServersConfig)
+	e.connectionTableConnections.Collect(ch)
+
+	e.collectLimitIdentifiersHits(limitIdentifierStats)
+	e.limitIdentifiersHits.Collect(ch)
+
+	e.collectLimitIdentifiersDrops(limitIdentifierStats)
+	e.limitIdentifiersDrops.Collect(ch)
+
+	e.collectLimitIdentifiersCurrentSessions(limitSessions)
+	e.limitIdentifiersCurrentSessions.Collect(ch)
+
+	e.collectLimitIdentifiersThreshold(limitIdentifiers)
+	e.limitIdentifiersThreshold.Collect(ch)
+
+	e.collectBotProfileDetections(botProfiles)
+	e.botProfileDetections.Collect(ch)
+
+	e.collectBotProfileActions(botProfiles)
+	e.botProfileActions.Collect(ch)
+
 	servicegroups, err := netscaler.GetServiceGroups(nsClient, "attrs=servicegroupname")
 	if err != nil {
 		level.Error(e.logger).Log("msg", err)
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// compressionStats represents the data returned from the /stat/cmp Nitro API endpoint
+type compressionStats struct {
+	TotalRequests       json.Number `json:"comptotalrequests"`
+	TotalRxBytes        json.Number `json:"comptotalrxbytes"`
+	TotalTxBytes        json.Number `json:"comptotaltxbytes"`
+	TotalRxPackets      json.Number `json:"comptotalrxpackets"`
+	TotalTxPackets      json.Number `json:"comptotaltxpackets"`
+	Ratio               float64     `json:"compratio"`
+	HTTPBandwidthSaving float64     `json:"comphttpbandwidthsaving"`
+	TCPTotalRxBytes     json.Number `json:"comptcptotalrxbytes"`
+	TCPTotalTxBytes     json.Number `json:"comptcptotaltxbytes"`
+	TCPRatio            float64     `json:"comptcpratio"`
+	TCPBandwidthSaving  float64     `json:"comptcpbandwidthsaving"`
+}
+
+// compressionPolicies represents the data returned from the /config/cmppolicy Nitro API endpoint
+type compressionPolicies struct {
+	Name      string      `json:"name"`
+	ResAction string      `json:"resaction"`
+	Hits      json.Number `json:"hits"`
+}
+
+// compressionActions represents the data returned from the /config/cmpaction Nitro API endpoint
+type compressionActions struct {
+	Name    string `json:"name"`
+	CmpType string `json:"cmptype"`
+}
+
+// getCompressionStats queries the Nitro API for compression stats
+func getCompressionStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "cmp", querystring)
+}
+
+// getCompressionPolicies queries the Nitro API for compression policies
+func getCompressionPolicies(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "cmppolicy", querystring)
+}
+
+// getCompressionActions queries the Nitro API for compression actions
+func getCompressionActions(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "cmpaction", querystring)
+}
+
+// compressionTypes maps compression action names to their compression type.
+// The built-in actions are named after their type, so they map to themselves
+func (r nitroResponse) compressionTypes() map[string]string {
+	types := map[string]string{
+		"COMPRESS":   "COMPRESS",
+		"GZIP":       "GZIP",
+		"DEFLATE":    "DEFLATE",
+		"NOCOMPRESS": "NOCOMPRESS",
+	}
+	for _, a := range r.CompressionActions {
+		types[a.Name] = strings.ToUpper(a.CmpType)
+	}
+	return types
+}
+
+const compressionSubsystem = "compression"
+
+var compressionLabels = []string{
+	netscalerInstance,
+}
+
+var compressionTypeLabels = []string{
+	netscalerInstance,
+	`citrixadc_compression_type`,
+}
+
+var (
+	compressionHTTPTotalRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_requests_total",
+			Help:      "Total number of HTTP compression requests the NetScaler received for which the response was compressed",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPTotalRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_uncompressed_bytes_total",
+			Help:      "Total number of bytes of HTTP data received from the servers which were eligible for compression",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPTotalTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_compressed_bytes_total",
+			Help:      "Total number of bytes of compressed HTTP data sent to the clients",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPTotalRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_uncompressed_packets_total",
+			Help:      "Total number of HTTP packets received from the servers which were eligible for compression",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPTotalTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_compressed_packets_total",
+			Help:      "Total number of compressed HTTP packets sent to the clients",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_ratio",
+			Help:      "Ratio of the compressible HTTP data received to the compressed data transmitted",
+		},
+		compressionLabels,
+	)
+
+	compressionHTTPBandwidthSaving = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "http_bandwidth_saving_pct",
+			Help:      "Bandwidth saving from HTTP compression, as a percentage",
+		},
+		compressionLabels,
+	)
+
+	compressionTCPTotalRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "tcp_uncompressed_bytes_total",
+			Help:      "Total number of bytes of TCP data received which were eligible for compression",
+		},
+		compressionLabels,
+	)
+
+	compressionTCPTotalTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "tcp_compressed_bytes_total",
+			Help:      "Total number of bytes of compressed TCP data transmitted",
+		},
+		compressionLabels,
+	)
+
+	compressionTCPRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "tcp_ratio",
+			Help:      "Ratio of the compressible TCP data received to the compressed data transmitted",
+		},
+		compressionLabels,
+	)
+
+	compressionTCPBandwidthSaving = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "tcp_bandwidth_saving_pct",
+			Help:      "Bandwidth saving from TCP compression, as a percentage",
+		},
+		compressionLabels,
+	)
+
+	compressionPolicyHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: compressionSubsystem,
+			Name:      "policy_hits_total",
+			Help:      "Total number of hits on compression policies, by the compression type of the policy's action. COMPRESS negotiates gzip, deflate or brotli with the client.",
+		},
+		compressionTypeLabels,
+	)
+)
+
+func (e *Exporter) collectCompressionHTTPTotalRequests(ns nitroResponse) {
+	e.compressionHTTPTotalRequests.Reset()
+
+	val, _ := ns.CompressionStats.TotalRequests.Float64()
+	e.compressionHTTPTotalRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionHTTPTotalRxBytes(ns nitroResponse) {
+	e.compressionHTTPTotalRxBytes.Reset()
+
+	val, _ := ns.CompressionStats.TotalRxBytes.Float64()
+	e.compressionHTTPTotalRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionHTTPTotalTxBytes(ns nitroResponse) {
+	e.compressionHTTPTotalTxBytes.Reset()
+
+	val, _ := ns.CompressionStats.TotalTxBytes.Float64()
+	e.compressionHTTPTotalTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionHTTPTotalRxPackets(ns nitroResponse) {
+	e.compressionHTTPTotalRxPackets.Reset()
+
+	val, _ := ns.CompressionStats.TotalRxPackets.Float64()
+	e.compressionHTTPTotalRxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionHTTPTotalTxPackets(ns nitroResponse) {
+	e.compressionHTTPTotalTxPackets.Reset()
+
+	val, _ := ns.CompressionStats.TotalTxPackets.Float64()
+	e.compressionHTTPTotalTxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionHTTPRatio(ns nitroResponse) {
+	e.compressionHTTPRatio.Reset()
+
+	e.compressionHTTPRatio.WithLabelValues(e.nsInstance).Set(ns.CompressionStats.Ratio)
+}
+
+func (e *Exporter) collectCompressionHTTPBandwidthSaving(ns nitroResponse) {
+	e.compressionHTTPBandwidthSaving.Reset()
+
+	e.compressionHTTPBandwidthSaving.WithLabelValues(e.nsInstance).Set(ns.CompressionStats.HTTPBandwidthSaving)
+}
+
+func (e *Exporter) collectCompressionTCPTotalRxBytes(ns nitroResponse) {
+	e.compressionTCPTotalRxBytes.Reset()
+
+	val, _ := ns.CompressionStats.TCPTotalRxBytes.Float64()
+	e.compressionTCPTotalRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionTCPTotalTxBytes(ns nitroResponse) {
+	e.compressionTCPTotalTxBytes.Reset()
+
+	val, _ := ns.CompressionStats.TCPTotalTxBytes.Float64()
+	e.compressionTCPTotalTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectCompressionTCPRatio(ns nitroResponse) {
+	e.compressionTCPRatio.Reset()
+
+	e.compressionTCPRatio.WithLabelValues(e.nsInstance).Set(ns.CompressionStats.TCPRatio)
+}
+
+func (e *Exporter) collectCompressionTCPBandwidthSaving(ns nitroResponse) {
+	e.compressionTCPBandwidthSaving.Reset()
+
+	e.compressionTCPBandwidthSaving.WithLabelValues(e.nsInstance).Set(ns.CompressionStats.TCPBandwidthSaving)
+}
+
+func (e *Exporter) collectCompressionPolicyHits(ns nitroResponse, actions nitroResponse) {
+	e.compressionPolicyHits.Reset()
+
+	types := actions.compressionTypes()
+	hits := make(map[string]float64)
+	for _, p := range ns.CompressionPolicies {
+		cmpType, ok := types[p.ResAction]
+		if !ok {
+			cmpType = p.ResAction
+		}
+		val, _ := p.Hits.Float64()
+		hits[cmpType] += val
+	}
+	for cmpType, val := range hits {
+		e.compressionPolicyHits.WithLabelValues(e.nsInstance, cmpType).Set(val)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// nsConfig represents the data returned from the /config/nsconfig Nitro API endpoint
+type nsConfig struct {
+	ConfigChanged         bool        `json:"configchanged"`
+	LastConfigChangedTime string      `json:"lastconfigchangedtime"`
+	LastConfigSaveTime    string      `json:"lastconfigsavetime"`
+	CurrentSystemTime     string      `json:"currentsytemtime"`
+	SystemTime            json.Number `json:"systemtime"`
+}
+
+// getNSConfig queries the Nitro API for the running config state
+func getNSConfig(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nsconfig", querystring)
+}
+
+// timestamp converts a time reported by Nitro to seconds since the Unix epoch.
+// The times are in the NetScaler's local timezone, so they are corrected using the offset between
+// the current system time in local time and in seconds since the epoch
+func (c nsConfig) timestamp(t string) (float64, error) {
+	parsed, err := time.Parse(nsTimeFormat, t)
+	if err != nil {
+		return 0, err
+	}
+
+	var offset float64
+	current, err1 := time.Parse(nsTimeFormat, c.CurrentSystemTime)
+	epoch, err2 := c.SystemTime.Float64()
+	if err1 == nil && err2 == nil {
+		offset = epoch - float64(current.Unix())
+	}
+
+	return float64(parsed.Unix()) + offset, nil
+}
+
+const configSubsystem = "config"
+
+var configLabels = []string{
+	netscalerInstance,
+}
+
+var (
+	configUnsavedChanges = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: configSubsystem,
+			Name:      "unsaved_changes",
+			Help:      "Whether the running config differs from the saved config. 0 = NO, 1 = YES",
+		},
+		configLabels,
+	)
+
+	configLastSaveTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: configSubsystem,
+			Name:      "last_save_timestamp_seconds",
+			Help:      "Time the config was last saved, in seconds since the Unix epoch",
+		},
+		configLabels,
+	)
+
+	configLastChangeTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: configSubsystem,
+			Name:      "last_change_timestamp_seconds",
+			Help:      "Time the running config was last changed, in seconds since the Unix epoch",
+		},
+		configLabels,
+	)
+)
+
+func (e *Exporter) collectConfigUnsavedChanges(ns nitroResponse) {
+	e.configUnsavedChanges.Reset()
+
+	var val float64
+	if ns.NSConfig.ConfigChanged {
+		val = 1.0
+	}
+	e.configUnsavedChanges.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectConfigLastSaveTimestamp(ns nitroResponse) {
+	e.configLastSaveTimestamp.Reset()
+
+	val, err := ns.NSConfig.timestamp(ns.NSConfig.LastConfigSaveTime)
+	// Not reported if the config has never been saved
+	if err != nil {
+		return
+	}
+	e.configLastSaveTimestamp.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectConfigLastChangeTimestamp(ns nitroResponse) {
+	e.configLastChangeTimestamp.Reset()
+
+	val, err := ns.NSConfig.timestamp(ns.NSConfig.LastConfigChangedTime)
+	// Not reported if the config hasn't changed since the NetScaler started
+	if err != nil {
+		return
+	}
+	e.configLastChangeTimestamp.WithLabelValues(e.nsInstance).Set(val)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNSConfigTimestamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		time      string
+		expected  float64
+		expectErr bool
+	}{
+		{
+			name: "UTC",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nsconfig": { "configchanged": true, "lastconfigchangedtime": "Fri Mar 20 08:30:00 2020",
+				"currentsytemtime": "Mon Mar 23 13:05:10 2020", "systemtime": "1584968710", "timezone": "CoordinatedUniversalTime" } }`,
+			time:     "Fri Mar 20 08:30:00 2020",
+			expected: 1584693000,
+		},
+		{
+			name: "ahead of UTC",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nsconfig": { "configchanged": true, "lastconfigchangedtime": "Fri Mar 20 09:30:00 2020",
+				"currentsytemtime": "Mon Mar 23 14:05:10 2020", "systemtime": "1584968710", "timezone": "GMT+01:00-CET-Europe/Amsterdam" } }`,
+			time:     "Fri Mar 20 09:30:00 2020",
+			expected: 1584693000,
+		},
+		{
+			name: "behind UTC",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nsconfig": { "configchanged": false, "lastconfigsavetime": "Mon Mar  2 09:15:42 2020",
+				"currentsytemtime": "Mon Mar 23 14:05:10 2020", "systemtime": "1584990310", "timezone": "GMT-05:00-CDT-America/Chicago" } }`,
+			time:     "Mon Mar  2 09:15:42 2020",
+			expected: 1583158542,
+		},
+		{
+			name:     "no system time",
+			body:     `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nsconfig": { "configchanged": true, "lastconfigchangedtime": "Fri Mar 20 08:30:00 2020" } }`,
+			time:     "Fri Mar 20 08:30:00 2020",
+			expected: 1584693000,
+		},
+		{
+			name: "never saved",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nsconfig": { "configchanged": true,
+				"currentsytemtime": "Mon Mar 23 13:05:10 2020", "systemtime": "1584968710" } }`,
+			time:      "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ns nitroResponse
+			if err := json.Unmarshal([]byte(tc.body), &ns); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			val, err := ns.NSConfig.timestamp(tc.time)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", val)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, val)
+			}
+		})
+	}
+}
@@ -78,13 +78,13 @@ func (db *DB) setNotCollecting() {
 
 func (db *DB) setLBServer(lbs lbserver) {
 	db.lock.Lock()
-	db.lbservers[lbs.url] = lbs
+	db.lbservers[mappingKey(lbs.url, lbs.partition)] = lbs
 	db.lock.Unlock()
 }
 
 func (db *DB) removeLBServer(lbs lbserver) {
 	db.lock.Lock()
-	delete(db.lbservers, lbs.url)
+	delete(db.lbservers, mappingKey(lbs.url, lbs.partition))
 	db.lock.Unlock()
 }
 
@@ -157,6 +157,13 @@ func (db *DB) collectVIPMap2(lbs lbserver) error {
 			fmt.Fprintf(os.Stderr, "error disconnecting ns client: %v\n", err)
 		}
 	}()
+	if lbs.partition != "" {
+		err = netscaler.SwitchPartition(nsClient, lbs.partition)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error switching partition: %v\n", err)
+			return err
+		}
+	}
 	nsBindings, err := netscaler.GetLBVSBindings(nsClient, "bulkbindings=yes")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error collecting bindings: %v\n", err)
@@ -166,7 +173,7 @@ func (db *DB) collectVIPMap2(lbs lbserver) error {
 	for _, b := range nsBindings.LBVServerServiceBindings {
 		kvMap[b.ServiceName] = b.Name
 	}
-	currentMapping.updateMappings(lbs.url, kvMap)
+	currentMapping.updateMappings(mappingKey(lbs.url, lbs.partition), kvMap)
 	/*
 		err = updateBatch(db.db, kvMap)
 		if err != nil {
@@ -257,11 +264,21 @@ func (v *VIPMap) loadMappingYaml(path string) bool {
 }
 
 type lbserver struct {
-	url    string
-	user   string
-	pass   string
-	ignore bool
-	ready  bool
+	url       string
+	user      string
+	pass      string
+	ignore    bool
+	partition string
+	ready     bool
+}
+
+// mappingKey returns the key the vip mappings of the target are stored under.  Entities in an admin partition are
+// separate from those in the default partition, so each partition has its own mappings
+func mappingKey(url, partition string) string {
+	if partition == "" {
+		return url
+	}
+	return url + "?partition=" + partition
 }
 
 func getValue(db *badger.DB, key string) string {
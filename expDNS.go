@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// dnsStats represents the data returned from the /stat/dns Nitro API endpoint
+type dnsStats struct {
+	TotQueries        json.Number `json:"dnstotqueries"`
+	TotAnswers        json.Number `json:"dnstotanswers"`
+	TotServerQuery    json.Number `json:"dnstotserverquery"`
+	TotServerResponse json.Number `json:"dnstotserverresponse"`
+	ErrNoDomain       json.Number `json:"dnserrnodomain"`
+	TotCacheHits      json.Number `json:"dnstotcachehits"`
+	TotCacheMiss      json.Number `json:"dnstotcachemiss"`
+	TotARecQueries    json.Number `json:"dnstotarecqueries"`
+	TotAAAARecQueries json.Number `json:"dnstotaaaarecqueries"`
+	TotCNAMERecQuery  json.Number `json:"dnstotcnamerecqueries"`
+	TotMXRecQueries   json.Number `json:"dnstotmxrecqueries"`
+	TotNSRecQueries   json.Number `json:"dnstotnsrecqueries"`
+	TotSOARecQueries  json.Number `json:"dnstotsoarecqueries"`
+	TotPTRRecQueries  json.Number `json:"dnstotptrrecqueries"`
+	TotSRVRecQueries  json.Number `json:"dnstotsrvrecqueries"`
+	TotTXTRecQueries  json.Number `json:"dnstottxtrecqueries"`
+	TotNAPTRRecQuery  json.Number `json:"dnstotnaptrrecqueries"`
+	TotCAARecQueries  json.Number `json:"dnstotcaarecqueries"`
+	TotAnyQueries     json.Number `json:"dnstotanyqueries"`
+}
+
+// getDNSStats queries the Nitro API for DNS stats
+func getDNSStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "dns", querystring)
+}
+
+// queriesByType breaks the queries down by record type
+func (s dnsStats) queriesByType() map[string]json.Number {
+	return map[string]json.Number{
+		"A":     s.TotARecQueries,
+		"AAAA":  s.TotAAAARecQueries,
+		"CNAME": s.TotCNAMERecQuery,
+		"MX":    s.TotMXRecQueries,
+		"NS":    s.TotNSRecQueries,
+		"SOA":   s.TotSOARecQueries,
+		"PTR":   s.TotPTRRecQueries,
+		"SRV":   s.TotSRVRecQueries,
+		"TXT":   s.TotTXTRecQueries,
+		"NAPTR": s.TotNAPTRRecQuery,
+		"CAA":   s.TotCAARecQueries,
+		"ANY":   s.TotAnyQueries,
+	}
+}
+
+const dnsSubsystem = "dns"
+
+var dnsLabels = []string{
+	netscalerInstance,
+}
+
+var dnsRecordTypeLabels = []string{
+	netscalerInstance,
+	`citrixadc_dns_record_type`,
+}
+
+var (
+	dnsQueries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "queries_total",
+			Help:      "Total number of DNS queries received",
+		},
+		dnsLabels,
+	)
+
+	dnsResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of DNS responses sent",
+		},
+		dnsLabels,
+	)
+
+	dnsServerQueries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "server_queries_total",
+			Help:      "Total number of DNS queries sent to the DNS servers",
+		},
+		dnsLabels,
+	)
+
+	dnsServerResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "server_responses_total",
+			Help:      "Total number of DNS responses received from the DNS servers",
+		},
+		dnsLabels,
+	)
+
+	dnsNXDomain = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "nxdomain_total",
+			Help:      "Total number of DNS queries for a domain which does not exist (NXDOMAIN)",
+		},
+		dnsLabels,
+	)
+
+	dnsCacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "cache_hits_total",
+			Help:      "Total number of DNS queries answered from the DNS cache",
+		},
+		dnsLabels,
+	)
+
+	dnsCacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "cache_misses_total",
+			Help:      "Total number of DNS queries which could not be answered from the DNS cache",
+		},
+		dnsLabels,
+	)
+
+	dnsQueriesByType = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: dnsSubsystem,
+			Name:      "record_type_queries_total",
+			Help:      "Total number of DNS queries received for the record type",
+		},
+		dnsRecordTypeLabels,
+	)
+)
+
+func (e *Exporter) collectDNSQueries(ns nitroResponse) {
+	e.dnsQueries.Reset()
+
+	val, _ := ns.DNSStats.TotQueries.Float64()
+	e.dnsQueries.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSResponses(ns nitroResponse) {
+	e.dnsResponses.Reset()
+
+	val, _ := ns.DNSStats.TotAnswers.Float64()
+	e.dnsResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSServerQueries(ns nitroResponse) {
+	e.dnsServerQueries.Reset()
+
+	val, _ := ns.DNSStats.TotServerQuery.Float64()
+	e.dnsServerQueries.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSServerResponses(ns nitroResponse) {
+	e.dnsServerResponses.Reset()
+
+	val, _ := ns.DNSStats.TotServerResponse.Float64()
+	e.dnsServerResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSNXDomain(ns nitroResponse) {
+	e.dnsNXDomain.Reset()
+
+	val, _ := ns.DNSStats.ErrNoDomain.Float64()
+	e.dnsNXDomain.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSCacheHits(ns nitroResponse) {
+	e.dnsCacheHits.Reset()
+
+	val, _ := ns.DNSStats.TotCacheHits.Float64()
+	e.dnsCacheHits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSCacheMisses(ns nitroResponse) {
+	e.dnsCacheMisses.Reset()
+
+	val, _ := ns.DNSStats.TotCacheMiss.Float64()
+	e.dnsCacheMisses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectDNSQueriesByType(ns nitroResponse) {
+	e.dnsQueriesByType.Reset()
+
+	for recordType, queries := range ns.DNSStats.queriesByType() {
+		val, err := queries.Float64()
+		// Older firmware doesn't report every record type
+		if err != nil {
+			continue
+		}
+		e.dnsQueriesByType.WithLabelValues(e.nsInstance, recordType).Set(val)
+	}
+}
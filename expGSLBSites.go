@@ -0,0 +1,235 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gslbSiteStats represents the data returned from the /stat/gslbsite and /config/gslbsite Nitro API endpoints.
+// Both are returned under the same key, so the stats populate the counters and the config populates State
+type gslbSiteStats struct {
+	Name                   string      `json:"sitename"`
+	State                  string      `json:"sitestate"`
+	MetricExchangeStatus   string      `json:"sitemetricmepstatus"`
+	NWMetricExchangeStatus string      `json:"nwmetricmepstatus"`
+	TotalRequests          json.Number `json:"sitetotalrequests"`
+	TotalResponses         json.Number `json:"sitetotalresponses"`
+	TotalRequestBytes      json.Number `json:"sitetotalrequestbytes"`
+	TotalResponseBytes     json.Number `json:"sitetotalresponsebytes"`
+	CurrentClientConns     json.Number `json:"sitecurclntconnections"`
+	CurrentServerConns     json.Number `json:"sitecursrvrconnections"`
+}
+
+// getGSLBSiteStats queries the Nitro API for GSLB site stats
+func getGSLBSiteStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "gslbsite", querystring)
+}
+
+// getGSLBSites queries the Nitro API for GSLB site config
+func getGSLBSites(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "gslbsite", querystring)
+}
+
+// mepStatus converts the metric exchange status to a number
+func mepStatus(status string) float64 {
+	switch status {
+	case `DOWN`, `INACTIVE`:
+		return 0.0
+	case `UP`, `ACTIVE`:
+		return 1.0
+	case `DISABLED`:
+		return 2.0
+	default:
+		return 3.0
+	}
+}
+
+const gslbSitesSubsystem = "gslb_site"
+
+var gslbSitesLabels = []string{
+	netscalerInstance,
+	`citrixadc_gslb_site`,
+}
+
+var (
+	gslbSitesState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "state",
+			Help:      "Current state of the site. 0 = DOWN, 1 = UP, 3 = UNKNOWN",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesMetricExchangeStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "metric_exchange_status",
+			Help:      "Status of the site metric exchange connection. 0 = DOWN, 1 = ACTIVE, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesNetworkMetricExchangeStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "network_metric_exchange_status",
+			Help:      "Status of the network metric exchange. 0 = DOWN, 1 = ACTIVE, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesTotalRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests received by the services in the site",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesTotalResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of responses received by the services in the site",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesTotalRequestBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of request bytes received by the services in the site",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesTotalResponseBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of response bytes received by the services in the site",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesCurrentClientConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "current_client_connections",
+			Help:      "Number of current client connections to the services in the site",
+		},
+		gslbSitesLabels,
+	)
+
+	gslbSitesCurrentServerConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gslbSitesSubsystem,
+			Name:      "current_server_connections",
+			Help:      "Number of current connections to the real servers behind the services in the site",
+		},
+		gslbSitesLabels,
+	)
+)
+
+func (e *Exporter) collectGSLBSitesState(ns nitroResponse) {
+	e.gslbSitesState.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		var state float64
+		switch site.State {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		default:
+			state = 3.0
+		}
+
+		e.gslbSitesState.WithLabelValues(e.nsInstance, site.Name).Set(state)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesMetricExchangeStatus(ns nitroResponse) {
+	e.gslbSitesMetricExchangeStatus.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		e.gslbSitesMetricExchangeStatus.WithLabelValues(e.nsInstance, site.Name).Set(mepStatus(site.MetricExchangeStatus))
+	}
+}
+
+func (e *Exporter) collectGSLBSitesNetworkMetricExchangeStatus(ns nitroResponse) {
+	e.gslbSitesNetworkMetricExchangeStatus.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		e.gslbSitesNetworkMetricExchangeStatus.WithLabelValues(e.nsInstance, site.Name).Set(mepStatus(site.NWMetricExchangeStatus))
+	}
+}
+
+func (e *Exporter) collectGSLBSitesTotalRequests(ns nitroResponse) {
+	e.gslbSitesTotalRequests.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.TotalRequests.Float64()
+		e.gslbSitesTotalRequests.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesTotalResponses(ns nitroResponse) {
+	e.gslbSitesTotalResponses.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.TotalResponses.Float64()
+		e.gslbSitesTotalResponses.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesTotalRequestBytes(ns nitroResponse) {
+	e.gslbSitesTotalRequestBytes.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.TotalRequestBytes.Float64()
+		e.gslbSitesTotalRequestBytes.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesTotalResponseBytes(ns nitroResponse) {
+	e.gslbSitesTotalResponseBytes.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.TotalResponseBytes.Float64()
+		e.gslbSitesTotalResponseBytes.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesCurrentClientConns(ns nitroResponse) {
+	e.gslbSitesCurrentClientConns.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.CurrentClientConns.Float64()
+		e.gslbSitesCurrentClientConns.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectGSLBSitesCurrentServerConns(ns nitroResponse) {
+	e.gslbSitesCurrentServerConns.Reset()
+
+	for _, site := range ns.GSLBSiteStats {
+		val, _ := site.CurrentServerConns.Float64()
+		e.gslbSitesCurrentServerConns.WithLabelValues(e.nsInstance, site.Name).Set(val)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// systemStats represents the environmental data returned from the /stat/system Nitro API endpoint.
+// Depending on the platform Nitro reports the sensor and disk readings as either numbers or strings, hence json.Number.
+type systemStats struct {
+	StartTime          string      `json:"starttime"`
+	CPU0Temp           json.Number `json:"cpu0temp"`
+	CPU1Temp           json.Number `json:"cpu1temp"`
+	InternalTemp       json.Number `json:"internaltemp"`
+	AuxTemp0           json.Number `json:"auxtemp0"`
+	AuxTemp1           json.Number `json:"auxtemp1"`
+	AuxTemp2           json.Number `json:"auxtemp2"`
+	AuxTemp3           json.Number `json:"auxtemp3"`
+	CPUFan0Speed       json.Number `json:"cpufan0speed"`
+	CPUFan1Speed       json.Number `json:"cpufan1speed"`
+	SystemFanSpeed     json.Number `json:"systemfanspeed"`
+	SystemFan1Speed    json.Number `json:"systemfan1speed"`
+	SystemFan2Speed    json.Number `json:"systemfan2speed"`
+	Fan0Speed          json.Number `json:"fan0speed"`
+	Fan2Speed          json.Number `json:"fan2speed"`
+	Fan3Speed          json.Number `json:"fan3speed"`
+	Fan4Speed          json.Number `json:"fan4speed"`
+	Fan5Speed          json.Number `json:"fan5speed"`
+	PowerSupply1Status string      `json:"powersupply1status"`
+	PowerSupply2Status string      `json:"powersupply2status"`
+	PowerSupply3Status string      `json:"powersupply3status"`
+	PowerSupply4Status string      `json:"powersupply4status"`
+	Disk0PerUsage      json.Number `json:"disk0perusage"`
+	Disk0Size          json.Number `json:"disk0size"`
+	Disk0Avail         json.Number `json:"disk0avail"`
+	Disk1PerUsage      json.Number `json:"disk1perusage"`
+	Disk1Size          json.Number `json:"disk1size"`
+	Disk1Avail         json.Number `json:"disk1avail"`
+}
+
+// getSystemStats queries the Nitro API for system stats
+func getSystemStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "system", querystring)
+}
+
+func (s systemStats) temperatures() map[string]json.Number {
+	return map[string]json.Number{
+		"cpu0":     s.CPU0Temp,
+		"cpu1":     s.CPU1Temp,
+		"internal": s.InternalTemp,
+		"aux0":     s.AuxTemp0,
+		"aux1":     s.AuxTemp1,
+		"aux2":     s.AuxTemp2,
+		"aux3":     s.AuxTemp3,
+	}
+}
+
+func (s systemStats) fans() map[string]json.Number {
+	return map[string]json.Number{
+		"cpu0":    s.CPUFan0Speed,
+		"cpu1":    s.CPUFan1Speed,
+		"system0": s.SystemFanSpeed,
+		"system1": s.SystemFan1Speed,
+		"system2": s.SystemFan2Speed,
+		"fan0":    s.Fan0Speed,
+		"fan2":    s.Fan2Speed,
+		"fan3":    s.Fan3Speed,
+		"fan4":    s.Fan4Speed,
+		"fan5":    s.Fan5Speed,
+	}
+}
+
+// hardwareDisk is a single disk partition reported by the system stats
+type hardwareDisk struct {
+	disk      string
+	partition string
+	perUsage  json.Number
+	size      json.Number
+	avail     json.Number
+}
+
+// disks returns the disk partitions reported by the appliance
+func (s systemStats) disks() []hardwareDisk {
+	var disks []hardwareDisk
+	if s.Disk0Size != "" {
+		disks = append(disks, hardwareDisk{disk: "disk0", partition: "/flash", perUsage: s.Disk0PerUsage, size: s.Disk0Size, avail: s.Disk0Avail})
+	}
+	if s.Disk1Size != "" {
+		disks = append(disks, hardwareDisk{disk: "disk1", partition: "/var", perUsage: s.Disk1PerUsage, size: s.Disk1Size, avail: s.Disk1Avail})
+	}
+	return disks
+}
+
+func (s systemStats) powerSupplies() map[string]string {
+	return map[string]string{
+		"1": s.PowerSupply1Status,
+		"2": s.PowerSupply2Status,
+		"3": s.PowerSupply3Status,
+		"4": s.PowerSupply4Status,
+	}
+}
+
+const hardwareSubsystem = "hw"
+
+var hardwareTemperatureLabels = []string{
+	netscalerInstance,
+	`sensor`,
+}
+
+var hardwareFanLabels = []string{
+	netscalerInstance,
+	`citrixadc_fan`,
+}
+
+var hardwarePowerSupplyLabels = []string{
+	netscalerInstance,
+	`citrixadc_power_supply`,
+}
+
+var hardwareDiskLabels = []string{
+	netscalerInstance,
+	`citrixadc_disk`,
+	`citrixadc_disk_partition`,
+}
+
+var (
+	hardwareTemperature = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "temperature_celsius",
+			Help:      "Temperature reported by the sensor, in degrees Celsius.  Only reported by physical appliances.",
+		},
+		hardwareTemperatureLabels,
+	)
+
+	hardwareFanSpeed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "fan_speed_rpm",
+			Help:      "Speed of the fan, in revolutions per minute.  Only reported by physical appliances.",
+		},
+		hardwareFanLabels,
+	)
+
+	hardwarePowerSupplyStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "power_supply_status",
+			Help:      "Status of the power supply. 0 = FAILED, 1 = NORMAL, 2 = NOT PRESENT, 3 = UNKNOWN",
+		},
+		hardwarePowerSupplyLabels,
+	)
+
+	hardwareDiskUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "disk_usage_pct",
+			Help:      "Used space on the disk partition, as a percentage.",
+		},
+		hardwareDiskLabels,
+	)
+
+	hardwareDiskSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "disk_size_bytes",
+			Help:      "Size of the disk partition",
+		},
+		hardwareDiskLabels,
+	)
+
+	hardwareDiskAvailable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: hardwareSubsystem,
+			Name:      "disk_available_bytes",
+			Help:      "Available space on the disk partition",
+		},
+		hardwareDiskLabels,
+	)
+)
+
+func (e *Exporter) collectHardwareTemperature(ns nitroResponse) {
+	e.hardwareTemperature.Reset()
+
+	for sensor, temp := range ns.SystemStats.temperatures() {
+		val, err := temp.Float64()
+		// Virtual appliances don't have the sensor, or report it as 0
+		if err != nil || val == 0 {
+			continue
+		}
+		e.hardwareTemperature.WithLabelValues(e.nsInstance, sensor).Set(val)
+	}
+}
+
+func (e *Exporter) collectHardwareFanSpeed(ns nitroResponse) {
+	e.hardwareFanSpeed.Reset()
+
+	for fan, speed := range ns.SystemStats.fans() {
+		val, err := speed.Float64()
+		// Virtual appliances don't have the fan, or report it as 0
+		if err != nil || val == 0 {
+			continue
+		}
+		e.hardwareFanSpeed.WithLabelValues(e.nsInstance, fan).Set(val)
+	}
+}
+
+func (e *Exporter) collectHardwarePowerSupplyStatus(ns nitroResponse) {
+	e.hardwarePowerSupplyStatus.Reset()
+
+	for psu, status := range ns.SystemStats.powerSupplies() {
+		var state float64
+		switch status {
+		case ``, `NOT SUPPORTED`:
+			continue
+		case `FAILED`:
+			state = 0.0
+		case `NORMAL`:
+			state = 1.0
+		case `NOT PRESENT`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+		e.hardwarePowerSupplyStatus.WithLabelValues(e.nsInstance, psu).Set(state)
+	}
+}
+
+func (e *Exporter) collectHardwareDiskUsage(ns nitroResponse) {
+	e.hardwareDiskUsage.Reset()
+
+	for _, d := range ns.SystemStats.disks() {
+		val, _ := d.perUsage.Float64()
+		e.hardwareDiskUsage.WithLabelValues(e.nsInstance, d.disk, d.partition).Set(val)
+	}
+}
+
+func (e *Exporter) collectHardwareDiskSize(ns nitroResponse) {
+	e.hardwareDiskSize.Reset()
+
+	for _, d := range ns.SystemStats.disks() {
+		val, _ := d.size.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.hardwareDiskSize.WithLabelValues(e.nsInstance, d.disk, d.partition).Set(val * 1024 * 1024)
+	}
+}
+
+func (e *Exporter) collectHardwareDiskAvailable(ns nitroResponse) {
+	e.hardwareDiskAvailable.Reset()
+
+	for _, d := range ns.SystemStats.disks() {
+		val, _ := d.avail.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.hardwareDiskAvailable.WithLabelValues(e.nsInstance, d.disk, d.partition).Set(val * 1024 * 1024)
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"strconv"
 
 	"github.com/jbvmio/netscaler"
@@ -8,6 +10,71 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// interfaceStats extends netscaler.InterfaceStats with the additional data returned from the /stat/interface Nitro API endpoint
+type interfaceStats struct {
+	netscaler.InterfaceStats
+	State                string `json:"curintfstate"`
+	LinkUptime           string `json:"curlinkuptime"`
+	LinkReinits          string `json:"linkreinits"`
+	ErrorPacketsTransmit string `json:"errpkttx"`
+	DroppedPacketsRx     string `json:"errdroppedrxpkts"`
+	DroppedPacketsTx     string `json:"errdroppedtxpkts"`
+	LinkHangs            string `json:"errlinkhangs"`
+	NICRxStalls          string `json:"nicrxstalls"`
+	NICTxStalls          string `json:"nictxstalls"`
+	MulticastPackets     string `json:"nicmulticastpkts"`
+}
+
+// interfaces represents the data returned from the /config/interface Nitro API endpoint
+type interfaces struct {
+	ID                      string `json:"id"`
+	Alias                   string `json:"ifalias"`
+	State                   string `json:"state"`
+	RequestedSpeed          string `json:"reqspeed"`
+	ActualSpeed             string `json:"actspeed"`
+	ActualDuplex            string `json:"actduplex"`
+	LACPActorInSync         string `json:"lacpactorinsync"`
+	LACPPartnerInSync       string `json:"lacppartnerinsync"`
+	LACPPartnerCollecting   string `json:"lacppartnercollecting"`
+	LACPPartnerDistributing string `json:"lacppartnerdistributing"`
+}
+
+// channels represents the data returned from the /config/channel Nitro API endpoint
+type channels struct {
+	ID     string   `json:"id"`
+	IfNums []string `json:"ifnum"`
+}
+
+// getInterfaceStats queries the Nitro API for interface stats
+func getInterfaceStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "interface", querystring)
+}
+
+// getInterfaces queries the Nitro API for interface configuration.
+// Nitro returns the configuration under the same "Interface" key as the stats, so it can't be unmarshalled into nitroResponse directly
+func getInterfaces(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	cfg, err := c.GetConfig("interface", querystring)
+	if err != nil {
+		return nitroResponse{}, err
+	}
+
+	var response struct {
+		Interfaces []interfaces `json:"Interface"`
+	}
+
+	err = json.Unmarshal(cfg, &response)
+	if err != nil {
+		return nitroResponse{}, errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	return nitroResponse{Interfaces: response.Interfaces}, nil
+}
+
+// getChannels queries the Nitro API for link aggregation channel configuration
+func getChannels(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "channel", querystring)
+}
+
 const interfacesSubsystem = "interface"
 
 var interfacesLabels = []string{
@@ -16,6 +83,13 @@ var interfacesLabels = []string{
 	`alias`,
 }
 
+var interfacesChannelLabels = []string{
+	netscalerInstance,
+	`interface`,
+	`alias`,
+	`channel`,
+}
+
 var (
 	interfacesRxBytes = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -86,9 +160,149 @@ var (
 		},
 		interfacesLabels,
 	)
+
+	interfacesErrorPacketsTx = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "transmitted_error_packets_total",
+			Help:      "Number of error packets transmitted by specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesDroppedPacketsRx = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "received_dropped_packets_total",
+			Help:      "Number of inbound packets dropped by specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesDroppedPacketsTx = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "transmitted_dropped_packets_total",
+			Help:      "Number of outbound packets dropped by specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesMulticastPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "multicast_packets_total",
+			Help:      "Number of multicast packets received by specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesLinkHangs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "hangs_total",
+			Help:      "Number of times a hang was detected on specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesNICRxStalls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "received_stalls_total",
+			Help:      "Number of times the NIC stalled while receiving on specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesNICTxStalls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "transmitted_stalls_total",
+			Help:      "Number of times the NIC stalled while transmitting on specific interfaces",
+		},
+		interfacesLabels,
+	)
+
+	interfacesLinkReinits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "link_reinitializations_total",
+			Help:      "Number of times the link on specific interfaces was reinitialized",
+		},
+		interfacesLabels,
+	)
+
+	interfacesLinkUptime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "link_uptime_seconds",
+			Help:      "Time since the link on specific interfaces last came up",
+		},
+		interfacesLabels,
+	)
+
+	interfacesLinkState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "link_state",
+			Help:      "Current link state of specific interfaces. 0 = DOWN, 1 = UP, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		interfacesLabels,
+	)
+
+	interfacesActualSpeed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "speed_bytes",
+			Help:      "Negotiated speed of specific interfaces, in bytes per second",
+		},
+		interfacesLabels,
+	)
+
+	interfacesRequestedSpeed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "configured_speed_bytes",
+			Help:      "Configured speed of specific interfaces, in bytes per second.  Not reported for interfaces set to auto-negotiate.",
+		},
+		interfacesLabels,
+	)
+
+	interfacesDuplex = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "duplex",
+			Help:      "Negotiated duplex of specific interfaces. 0 = HALF, 1 = FULL, 2 = UNKNOWN",
+		},
+		interfacesLabels,
+	)
+
+	interfacesChannelInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: interfacesSubsystem,
+			Name:      "channel_info",
+			Help:      "Link aggregation channel which specific interfaces are a member of.  Always 1.",
+		},
+		interfacesChannelLabels,
+	)
 )
 
-func (e *Exporter) collectInterfacesRxBytes(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesRxBytes(ns nitroResponse) {
 	e.interfacesRxBytes.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -97,7 +311,7 @@ func (e *Exporter) collectInterfacesRxBytes(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesTxBytes(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesTxBytes(ns nitroResponse) {
 	e.interfacesTxBytes.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -106,7 +320,7 @@ func (e *Exporter) collectInterfacesTxBytes(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesRxPackets(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesRxPackets(ns nitroResponse) {
 	e.interfacesRxPackets.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -115,7 +329,7 @@ func (e *Exporter) collectInterfacesRxPackets(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesTxPackets(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesTxPackets(ns nitroResponse) {
 	e.interfacesTxPackets.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -124,7 +338,7 @@ func (e *Exporter) collectInterfacesTxPackets(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesJumboPacketsRx(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesJumboPacketsRx(ns nitroResponse) {
 	e.interfacesJumboPacketsRx.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -133,7 +347,7 @@ func (e *Exporter) collectInterfacesJumboPacketsRx(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesJumboPacketsTx(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesJumboPacketsTx(ns nitroResponse) {
 	e.interfacesJumboPacketsTx.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -142,7 +356,7 @@ func (e *Exporter) collectInterfacesJumboPacketsTx(ns netscaler.NSAPIResponse) {
 	}
 }
 
-func (e *Exporter) collectInterfacesErrorPacketsRx(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectInterfacesErrorPacketsRx(ns nitroResponse) {
 	e.interfacesErrorPacketsRx.Reset()
 
 	for _, iface := range ns.InterfaceStats {
@@ -150,3 +364,165 @@ func (e *Exporter) collectInterfacesErrorPacketsRx(ns netscaler.NSAPIResponse) {
 		e.interfacesErrorPacketsRx.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
 	}
 }
+
+func (e *Exporter) collectInterfacesErrorPacketsTx(ns nitroResponse) {
+	e.interfacesErrorPacketsTx.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.ErrorPacketsTransmit, 64)
+		e.interfacesErrorPacketsTx.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesDroppedPacketsRx(ns nitroResponse) {
+	e.interfacesDroppedPacketsRx.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.DroppedPacketsRx, 64)
+		e.interfacesDroppedPacketsRx.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesDroppedPacketsTx(ns nitroResponse) {
+	e.interfacesDroppedPacketsTx.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.DroppedPacketsTx, 64)
+		e.interfacesDroppedPacketsTx.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesMulticastPackets(ns nitroResponse) {
+	e.interfacesMulticastPackets.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.MulticastPackets, 64)
+		e.interfacesMulticastPackets.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesLinkHangs(ns nitroResponse) {
+	e.interfacesLinkHangs.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.LinkHangs, 64)
+		e.interfacesLinkHangs.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesNICRxStalls(ns nitroResponse) {
+	e.interfacesNICRxStalls.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.NICRxStalls, 64)
+		e.interfacesNICRxStalls.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesNICTxStalls(ns nitroResponse) {
+	e.interfacesNICTxStalls.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.NICTxStalls, 64)
+		e.interfacesNICTxStalls.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesLinkReinits(ns nitroResponse) {
+	e.interfacesLinkReinits.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.LinkReinits, 64)
+		e.interfacesLinkReinits.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesLinkUptime(ns nitroResponse) {
+	e.interfacesLinkUptime.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		val, _ := strconv.ParseFloat(iface.LinkUptime, 64)
+		e.interfacesLinkUptime.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val)
+	}
+}
+
+func (e *Exporter) collectInterfacesLinkState(ns nitroResponse) {
+	e.interfacesLinkState.Reset()
+
+	for _, iface := range ns.InterfaceStats {
+		var state float64
+		switch iface.State {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		case `DISABLED`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+
+		e.interfacesLinkState.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(state)
+	}
+}
+
+func (e *Exporter) collectInterfacesActualSpeed(ns nitroResponse) {
+	e.interfacesActualSpeed.Reset()
+
+	for _, iface := range ns.Interfaces {
+		val, err := strconv.ParseFloat(iface.ActualSpeed, 64)
+		// Speed is reported as N/A when the link is down
+		if err != nil {
+			continue
+		}
+		// Value is in megabits per second. Convert to base unit of bytes per second
+		e.interfacesActualSpeed.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val * 1000 * 1000 / 8)
+	}
+}
+
+func (e *Exporter) collectInterfacesRequestedSpeed(ns nitroResponse) {
+	e.interfacesRequestedSpeed.Reset()
+
+	for _, iface := range ns.Interfaces {
+		val, err := strconv.ParseFloat(iface.RequestedSpeed, 64)
+		// Speed is reported as AUTO when the interface auto-negotiates
+		if err != nil {
+			continue
+		}
+		// Value is in megabits per second. Convert to base unit of bytes per second
+		e.interfacesRequestedSpeed.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(val * 1000 * 1000 / 8)
+	}
+}
+
+func (e *Exporter) collectInterfacesDuplex(ns nitroResponse) {
+	e.interfacesDuplex.Reset()
+
+	for _, iface := range ns.Interfaces {
+		var duplex float64
+		switch iface.ActualDuplex {
+		case `HALF`:
+			duplex = 0.0
+		case `FULL`:
+			duplex = 1.0
+		default:
+			duplex = 2.0
+		}
+
+		e.interfacesDuplex.WithLabelValues(e.nsInstance, iface.ID, iface.Alias).Set(duplex)
+	}
+}
+
+func (e *Exporter) collectInterfacesChannelInfo(ns nitroResponse, channels nitroResponse) {
+	e.interfacesChannelInfo.Reset()
+
+	aliases := make(map[string]string)
+	for _, iface := range ns.Interfaces {
+		aliases[iface.ID] = iface.Alias
+	}
+
+	for _, channel := range channels.Channels {
+		for _, ifnum := range channel.IfNums {
+			e.interfacesChannelInfo.WithLabelValues(e.nsInstance, ifnum, aliases[ifnum], channel.ID).Set(1)
+		}
+	}
+}
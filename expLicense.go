@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// nsLicense represents the data returned from the /config/nslicense Nitro API endpoint.
+// Every licensable feature is returned as a boolean keyed by its short name, so they are gathered up by UnmarshalJSON
+type nsLicense struct {
+	ModelID          string
+	DaysToExpiration json.Number
+	Features         map[string]bool
+}
+
+// UnmarshalJSON collects the boolean feature flags alongside the other license details
+func (l *nsLicense) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	l.Features = make(map[string]bool)
+	for k, v := range raw {
+		switch k {
+		case "modelid":
+			if err := json.Unmarshal(v, &l.ModelID); err != nil {
+				return err
+			}
+		case "daystoexpiration":
+			if err := json.Unmarshal(v, &l.DaysToExpiration); err != nil {
+				return err
+			}
+		default:
+			// The edition flags (isstandardlic etc.) are booleans too, but aren't features
+			if strings.HasPrefix(k, "is") && strings.HasSuffix(k, "lic") {
+				continue
+			}
+			var enabled bool
+			// Anything which isn't a boolean isn't a feature flag
+			if err := json.Unmarshal(v, &enabled); err == nil {
+				l.Features[k] = enabled
+			}
+		}
+	}
+
+	return nil
+}
+
+// nsCapacity represents the data returned from the /config/nscapacity Nitro API endpoint
+type nsCapacity struct {
+	Bandwidth       json.Number `json:"bandwidth"`
+	ActualBandwidth json.Number `json:"actualbandwidth"`
+	Unit            string      `json:"unit"`
+}
+
+// getNSLicense queries the Nitro API for license config
+func getNSLicense(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nslicense", querystring)
+}
+
+// getNSCapacity queries the Nitro API for the licensed capacity
+func getNSCapacity(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nscapacity", querystring)
+}
+
+// bandwidthBytes converts a bandwidth reported in the given unit to bytes per second
+func bandwidthBytes(bandwidth json.Number, unit string) (float64, error) {
+	val, err := bandwidth.Float64()
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.EqualFold(unit, "Gbps") {
+		val = val * 1000
+	}
+
+	// Value is in megabits per second. Convert to base unit of bytes per second
+	return val * 1000 * 1000 / 8, nil
+}
+
+const licenseSubsystem = "license"
+
+var licenseLabels = []string{
+	netscalerInstance,
+}
+
+var licenseFeatureLabels = []string{
+	netscalerInstance,
+	`feature`,
+}
+
+var (
+	licenseFeatureEnabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: licenseSubsystem,
+			Name:      "feature_enabled",
+			Help:      "Whether the feature is licensed. 0 = NO, 1 = YES",
+		},
+		licenseFeatureLabels,
+	)
+
+	licenseExpiryDays = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: licenseSubsystem,
+			Name:      "expiry_days",
+			Help:      "Number of days until the license expires.  Only reported for subscription and pooled licenses.",
+		},
+		licenseLabels,
+	)
+
+	licenseThroughput = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: licenseSubsystem,
+			Name:      "throughput_bytes",
+			Help:      "Throughput allocated by the pooled capacity license, in bytes per second",
+		},
+		licenseLabels,
+	)
+
+	licenseActualThroughput = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: licenseSubsystem,
+			Name:      "actual_throughput_bytes",
+			Help:      "Throughput the NetScaler is actually licensed for, in bytes per second.  Lower than the allocated throughput if the allocation could not be checked out.",
+		},
+		licenseLabels,
+	)
+)
+
+func (e *Exporter) collectLicenseFeatureEnabled(ns nitroResponse) {
+	e.licenseFeatureEnabled.Reset()
+
+	for feature, enabled := range ns.NSLicense.Features {
+		var val float64
+		if enabled {
+			val = 1.0
+		}
+		e.licenseFeatureEnabled.WithLabelValues(e.nsInstance, feature).Set(val)
+	}
+}
+
+func (e *Exporter) collectLicenseExpiryDays(ns nitroResponse) {
+	e.licenseExpiryDays.Reset()
+
+	val, err := ns.NSLicense.DaysToExpiration.Float64()
+	// Perpetual licenses don't expire
+	if err != nil {
+		return
+	}
+	e.licenseExpiryDays.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectLicenseThroughput(ns nitroResponse) {
+	e.licenseThroughput.Reset()
+
+	val, err := bandwidthBytes(ns.NSCapacity.Bandwidth, ns.NSCapacity.Unit)
+	// Only pooled capacity licenses report the throughput
+	if err != nil {
+		return
+	}
+	e.licenseThroughput.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectLicenseActualThroughput(ns nitroResponse) {
+	e.licenseActualThroughput.Reset()
+
+	val, err := bandwidthBytes(ns.NSCapacity.ActualBandwidth, ns.NSCapacity.Unit)
+	// Only pooled capacity licenses report the throughput
+	if err != nil {
+		return
+	}
+	e.licenseActualThroughput.WithLabelValues(e.nsInstance).Set(val)
+}
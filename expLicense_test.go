@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNSLicenseUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		modelID    string
+		expiration json.Number
+		features   map[string]bool
+	}{
+		{
+			name: "platinum VPX",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nslicense": { "wl": false, "sp": true, "lb": true, "cs": true, "cr": true, "cmp": true,
+				"ssl": true, "gslb": true, "gslbp": true, "rewrite": true, "responder": true, "appfw": true, "bot": true, "f_sslvpn_users": "4294967295",
+				"f_ica_users": "4294967295", "modelid": "1000", "isstandardlic": false, "isenterpriselic": false, "isplatinumlic": true,
+				"issgwylic": false, "isswglic": false, "daystoexpiration": "342" } }`,
+			modelID:    "1000",
+			expiration: "342",
+			features: map[string]bool{
+				"wl": false, "sp": true, "lb": true, "cs": true, "cr": true, "cmp": true, "ssl": true, "gslb": true,
+				"gslbp": true, "rewrite": true, "responder": true, "appfw": true, "bot": true,
+			},
+		},
+		{
+			name: "permanent standard license",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "nslicense": { "lb": true, "cs": false, "ssl": true, "appfw": false,
+				"modelid": "200", "isstandardlic": true, "isenterpriselic": false, "isplatinumlic": false } }`,
+			modelID: "200",
+			features: map[string]bool{
+				"lb": true, "cs": false, "ssl": true, "appfw": false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ns nitroResponse
+			if err := json.Unmarshal([]byte(tc.body), &ns); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if ns.NSLicense.ModelID != tc.modelID {
+				t.Errorf("expected model ID %q, got %q", tc.modelID, ns.NSLicense.ModelID)
+			}
+			if ns.NSLicense.DaysToExpiration != tc.expiration {
+				t.Errorf("expected days to expiration %q, got %q", tc.expiration, ns.NSLicense.DaysToExpiration)
+			}
+			if !reflect.DeepEqual(ns.NSLicense.Features, tc.features) {
+				t.Errorf("expected features %v, got %v", tc.features, ns.NSLicense.Features)
+			}
+		})
+	}
+}
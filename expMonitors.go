@@ -0,0 +1,422 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// monitors represents the data returned from the /config/lbmonitor Nitro API endpoint
+type monitors struct {
+	Name string `json:"monitorname"`
+	Type string `json:"type"`
+}
+
+// serviceMonitorBindings represents the data returned from the /config/service_lbmonitor_binding Nitro API endpoint
+type serviceMonitorBindings struct {
+	Name                string      `json:"name"`
+	MonitorName         string      `json:"monitor_name"`
+	MonitorState        string      `json:"monitor_state"`
+	ResponseTime        json.Number `json:"responsetime"`
+	TotalProbes         json.Number `json:"monitortotalprobes"`
+	TotalFailedProbes   json.Number `json:"monitortotalfailedprobes"`
+	CurrentFailedProbes json.Number `json:"monitorcurrentfailedprobes"`
+	LastResponse        string      `json:"lastresponse"`
+}
+
+// serviceGroupMonitorBindings represents the data returned from the /config/servicegroup_lbmonitor_binding Nitro API endpoint
+type serviceGroupMonitorBindings struct {
+	ServiceGroupName string `json:"servicegroupname"`
+	MonitorName      string `json:"monitor_name"`
+	MonState         string `json:"monstate"`
+}
+
+// serviceGroupMemberMonitorBindings represents the data returned from the /config/servicegroup_servicegroupentitymonbindings_binding Nitro API endpoint
+type serviceGroupMemberMonitorBindings struct {
+	ServiceGroupName    string      `json:"servicegroupname"`
+	MemberName          string      `json:"servicegroupentname2"`
+	MonitorName         string      `json:"monitor_name"`
+	MonitorState        string      `json:"monitor_state"`
+	ResponseTime        json.Number `json:"responsetime"`
+	TotalProbes         json.Number `json:"monitortotalprobes"`
+	TotalFailedProbes   json.Number `json:"monitortotalfailedprobes"`
+	CurrentFailedProbes json.Number `json:"monitorcurrentfailedprobes"`
+	LastResponse        string      `json:"lastresponse"`
+}
+
+// getMonitors queries the Nitro API for monitor config
+func getMonitors(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "lbmonitor", querystring)
+}
+
+// getServiceMonitorBindings queries the Nitro API for the monitors bound to each service
+func getServiceMonitorBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "service_lbmonitor_binding", querystring)
+}
+
+// getServiceGroupMonitorBindings queries the Nitro API for the monitors bound to each service group
+func getServiceGroupMonitorBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "servicegroup_lbmonitor_binding", querystring)
+}
+
+// getServiceGroupMemberMonitorBindings queries the Nitro API for the monitors bound to each service group member
+func getServiceGroupMemberMonitorBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "servicegroup_servicegroupentitymonbindings_binding", querystring)
+}
+
+// member returns the service group member as labelled by the service group stats.
+// Nitro names the member servicegroupname?ip?port
+func (b serviceGroupMemberMonitorBindings) member() string {
+	parts := strings.Split(b.MemberName, "?")
+	if len(parts) < 3 {
+		return b.MemberName
+	}
+	return parts[1] + `:` + parts[2]
+}
+
+// monitorTypes maps monitor names to their type
+func (r nitroResponse) monitorTypes() map[string]string {
+	types := make(map[string]string, len(r.Monitors))
+	for _, m := range r.Monitors {
+		types[m.Name] = m.Type
+	}
+	return types
+}
+
+const monitorsSubsystem = "monitor"
+
+var monitorsServiceLabels = []string{
+	netscalerInstance,
+	`citrixadc_service_name`,
+	`citrixadc_monitor_name`,
+	`citrixadc_monitor_type`,
+}
+
+var monitorsServiceLastResponseLabels = []string{
+	netscalerInstance,
+	`citrixadc_service_name`,
+	`citrixadc_monitor_name`,
+	`citrixadc_monitor_type`,
+	`citrixadc_monitor_last_response`,
+}
+
+var monitorsServiceGroupLabels = []string{
+	netscalerInstance,
+	`citrixadc_servicegroup_name`,
+	`citrixadc_monitor_name`,
+	`citrixadc_monitor_type`,
+}
+
+var monitorsServiceGroupMemberLabels = []string{
+	netscalerInstance,
+	`citrixadc_servicegroup_name`,
+	`citrixadc_servicegroup_member`,
+	`citrixadc_monitor_name`,
+	`citrixadc_monitor_type`,
+}
+
+var monitorsServiceGroupMemberLastResponseLabels = []string{
+	netscalerInstance,
+	`citrixadc_servicegroup_name`,
+	`citrixadc_servicegroup_member`,
+	`citrixadc_monitor_name`,
+	`citrixadc_monitor_type`,
+	`citrixadc_monitor_last_response`,
+}
+
+var (
+	monitorsServiceState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_state",
+			Help:      "Current state of the monitor on the service. 0 = DOWN, 1 = UP, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		monitorsServiceLabels,
+	)
+
+	monitorsServiceResponseTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_response_time_seconds",
+			Help:      "Response time of the last probe sent by the monitor to the service",
+		},
+		monitorsServiceLabels,
+	)
+
+	monitorsServiceProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_probes_total",
+			Help:      "Total number of probes sent by the monitor to the service",
+		},
+		monitorsServiceLabels,
+	)
+
+	monitorsServiceFailedProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_failed_probes_total",
+			Help:      "Total number of probes sent by the monitor to the service which failed",
+		},
+		monitorsServiceLabels,
+	)
+
+	monitorsServiceCurrentFailedProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_current_failed_probes",
+			Help:      "Number of consecutive probes sent by the monitor to the service which have failed",
+		},
+		monitorsServiceLabels,
+	)
+
+	monitorsServiceLastResponse = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "service_last_response_info",
+			Help:      "Last response received by the monitor from the service, exposed as the citrixadc_monitor_last_response label. The value is always 1.",
+		},
+		monitorsServiceLastResponseLabels,
+	)
+
+	monitorsServiceGroupState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_binding_state",
+			Help:      "State of the monitor binding on the service group. 0 = DISABLED, 1 = ENABLED, 3 = UNKNOWN",
+		},
+		monitorsServiceGroupLabels,
+	)
+
+	monitorsServiceGroupMemberState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_state",
+			Help:      "Current state of the monitor on the service group member. 0 = DOWN, 1 = UP, 2 = DISABLED, 3 = UNKNOWN",
+		},
+		monitorsServiceGroupMemberLabels,
+	)
+
+	monitorsServiceGroupMemberResponseTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_response_time_seconds",
+			Help:      "Response time of the last probe sent by the monitor to the service group member",
+		},
+		monitorsServiceGroupMemberLabels,
+	)
+
+	monitorsServiceGroupMemberProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_probes_total",
+			Help:      "Total number of probes sent by the monitor to the service group member",
+		},
+		monitorsServiceGroupMemberLabels,
+	)
+
+	monitorsServiceGroupMemberFailedProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_failed_probes_total",
+			Help:      "Total number of probes sent by the monitor to the service group member which failed",
+		},
+		monitorsServiceGroupMemberLabels,
+	)
+
+	monitorsServiceGroupMemberCurrentFailedProbes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_current_failed_probes",
+			Help:      "Number of consecutive probes sent by the monitor to the service group member which have failed",
+		},
+		monitorsServiceGroupMemberLabels,
+	)
+
+	monitorsServiceGroupMemberLastResponse = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: monitorsSubsystem,
+			Name:      "servicegroup_member_last_response_info",
+			Help:      "Last response received by the monitor from the service group member, exposed as the citrixadc_monitor_last_response label. The value is always 1.",
+		},
+		monitorsServiceGroupMemberLastResponseLabels,
+	)
+)
+
+func (e *Exporter) collectMonitorsServiceState(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceState.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		var state float64
+		switch b.MonitorState {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		case `DISABLED`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+		e.monitorsServiceState.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName]).Set(state)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceResponseTime(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceResponseTime.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		val, _ := b.ResponseTime.Float64()
+		// Value is in milliseconds. Convert to base unit of seconds
+		e.monitorsServiceResponseTime.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName]).Set(val * 0.001)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		val, _ := b.TotalProbes.Float64()
+		e.monitorsServiceProbes.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceFailedProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceFailedProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		val, _ := b.TotalFailedProbes.Float64()
+		e.monitorsServiceFailedProbes.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceCurrentFailedProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceCurrentFailedProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		val, _ := b.CurrentFailedProbes.Float64()
+		e.monitorsServiceCurrentFailedProbes.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceLastResponse(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceLastResponse.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceMonitorBindings {
+		e.monitorsServiceLastResponse.WithLabelValues(e.nsInstance, b.Name, b.MonitorName, types[b.MonitorName], b.LastResponse).Set(1)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupState(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupState.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMonitorBindings {
+		var state float64
+		switch b.MonState {
+		case `DISABLED`:
+			state = 0.0
+		case `ENABLED`:
+			state = 1.0
+		default:
+			state = 3.0
+		}
+		e.monitorsServiceGroupState.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.MonitorName, types[b.MonitorName]).Set(state)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberState(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberState.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		var state float64
+		switch b.MonitorState {
+		case `DOWN`:
+			state = 0.0
+		case `UP`:
+			state = 1.0
+		case `DISABLED`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+		e.monitorsServiceGroupMemberState.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName]).Set(state)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberResponseTime(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberResponseTime.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		// Not every firmware reports the response time for service group members
+		if b.ResponseTime == "" {
+			continue
+		}
+		val, _ := b.ResponseTime.Float64()
+		// Value is in milliseconds. Convert to base unit of seconds
+		e.monitorsServiceGroupMemberResponseTime.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName]).Set(val * 0.001)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		val, _ := b.TotalProbes.Float64()
+		e.monitorsServiceGroupMemberProbes.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberFailedProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberFailedProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		val, _ := b.TotalFailedProbes.Float64()
+		e.monitorsServiceGroupMemberFailedProbes.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberCurrentFailedProbes(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberCurrentFailedProbes.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		val, _ := b.CurrentFailedProbes.Float64()
+		e.monitorsServiceGroupMemberCurrentFailedProbes.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName]).Set(val)
+	}
+}
+
+func (e *Exporter) collectMonitorsServiceGroupMemberLastResponse(ns nitroResponse, monitors nitroResponse) {
+	e.monitorsServiceGroupMemberLastResponse.Reset()
+
+	types := monitors.monitorTypes()
+	for _, b := range ns.ServiceGroupMemberMonitorBindings {
+		e.monitorsServiceGroupMemberLastResponse.WithLabelValues(e.nsInstance, b.ServiceGroupName, b.member(), b.MonitorName, types[b.MonitorName], b.LastResponse).Set(1)
+	}
+}
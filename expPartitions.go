@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// partitionStats represents the data returned from the /stat/nspartition Nitro API endpoint
+type partitionStats struct {
+	Name               string      `json:"partitionname"`
+	CurrentBandwidth   json.Number `json:"currentbandwidth"`
+	MaxBandwidth       json.Number `json:"maxbandwidth"`
+	CurrentConnections json.Number `json:"currentconnections"`
+	MaxConnections     json.Number `json:"maxconnections"`
+	MemoryUsagePcnt    float64     `json:"memoryusagepcnt"`
+	MaxMemory          json.Number `json:"maxmemory"`
+}
+
+// getPartitionStats queries the Nitro API for admin partition stats
+func getPartitionStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "nspartition", querystring)
+}
+
+// partitionMetric is a metric collected from an admin partition.  The partition is added to it as a constant
+// citrixadc_partition label when it's written, the same way ConstLabels are written by the client library
+type partitionMetric struct {
+	prometheus.Metric
+	partition string
+}
+
+// Write implements prometheus.Metric
+func (m partitionMetric) Write(out *dto.Metric) error {
+	if err := m.Metric.Write(out); err != nil {
+		return err
+	}
+
+	name, value := `citrixadc_partition`, m.partition
+	out.Label = append(out.Label, &dto.LabelPair{Name: &name, Value: &value})
+	sort.Slice(out.Label, func(i, j int) bool {
+		return out.Label[i].GetName() < out.Label[j].GetName()
+	})
+	return nil
+}
+
+const partitionsSubsystem = "partition"
+
+var partitionsLabels = []string{
+	netscalerInstance,
+	`citrixadc_partition_name`,
+}
+
+var (
+	partitionsCurrentBandwidth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "bandwidth_bytes",
+			Help:      "Current bandwidth used by the admin partition, in bytes per second",
+		},
+		partitionsLabels,
+	)
+
+	partitionsMaxBandwidth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "max_bandwidth_bytes",
+			Help:      "Maximum bandwidth the admin partition is allowed to use, in bytes per second",
+		},
+		partitionsLabels,
+	)
+
+	partitionsCurrentConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "connections",
+			Help:      "Current connections to the entities in the admin partition",
+		},
+		partitionsLabels,
+	)
+
+	partitionsMaxConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "max_connections",
+			Help:      "Maximum connections the admin partition is allowed",
+		},
+		partitionsLabels,
+	)
+
+	partitionsMemoryUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "memory_usage_pct",
+			Help:      "Memory used by the admin partition, as a percentage of its maximum memory",
+		},
+		partitionsLabels,
+	)
+
+	partitionsMaxMemory = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: partitionsSubsystem,
+			Name:      "max_memory_bytes",
+			Help:      "Maximum memory the admin partition is allowed to use",
+		},
+		partitionsLabels,
+	)
+)
+
+func (e *Exporter) collectPartitionsCurrentBandwidth(ns nitroResponse) {
+	e.partitionsCurrentBandwidth.Reset()
+
+	for _, p := range ns.PartitionStats {
+		val, _ := p.CurrentBandwidth.Float64()
+		// Value is in kilobits per second. Convert to base unit of bytes per second
+		e.partitionsCurrentBandwidth.WithLabelValues(e.nsInstance, p.Name).Set(val * 1000 / 8)
+	}
+}
+
+func (e *Exporter) collectPartitionsMaxBandwidth(ns nitroResponse) {
+	e.partitionsMaxBandwidth.Reset()
+
+	for _, p := range ns.PartitionStats {
+		val, _ := p.MaxBandwidth.Float64()
+		// Value is in kilobits per second. Convert to base unit of bytes per second
+		e.partitionsMaxBandwidth.WithLabelValues(e.nsInstance, p.Name).Set(val * 1000 / 8)
+	}
+}
+
+func (e *Exporter) collectPartitionsCurrentConnections(ns nitroResponse) {
+	e.partitionsCurrentConnections.Reset()
+
+	for _, p := range ns.PartitionStats {
+		val, _ := p.CurrentConnections.Float64()
+		e.partitionsCurrentConnections.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectPartitionsMaxConnections(ns nitroResponse) {
+	e.partitionsMaxConnections.Reset()
+
+	for _, p := range ns.PartitionStats {
+		val, _ := p.MaxConnections.Float64()
+		e.partitionsMaxConnections.WithLabelValues(e.nsInstance, p.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectPartitionsMemoryUsage(ns nitroResponse) {
+	e.partitionsMemoryUsage.Reset()
+
+	for _, p := range ns.PartitionStats {
+		e.partitionsMemoryUsage.WithLabelValues(e.nsInstance, p.Name).Set(p.MemoryUsagePcnt)
+	}
+}
+
+func (e *Exporter) collectPartitionsMaxMemory(ns nitroResponse) {
+	e.partitionsMaxMemory.Reset()
+
+	for _, p := range ns.PartitionStats {
+		val, _ := p.MaxMemory.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.partitionsMaxMemory.WithLabelValues(e.nsInstance, p.Name).Set(val * 1024 * 1024)
+	}
+}
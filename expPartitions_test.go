@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestPartitionMetricWrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		metric   prometheus.Metric
+		expected map[string]string
+	}{
+		{
+			name: "service state",
+			metric: prometheus.MustNewConstMetric(
+				prometheus.NewDesc("citrixadc_service_state", "State", []string{netscalerInstance, `citrixadc_service_name`}, nil),
+				prometheus.GaugeValue, 1, "netscaler.domain.tld", "svc_web_01",
+			),
+			expected: map[string]string{
+				netscalerInstance:        "netscaler.domain.tld",
+				`citrixadc_service_name`: "svc_web_01",
+				`citrixadc_partition`:    "bu_retail",
+			},
+		},
+		{
+			name: "partition connections",
+			metric: prometheus.MustNewConstMetric(
+				prometheus.NewDesc("citrixadc_partition_connections", "Connections", partitionsLabels, nil),
+				prometheus.GaugeValue, 42, "netscaler.domain.tld", "bu_finance",
+			),
+			expected: map[string]string{
+				netscalerInstance:          "netscaler.domain.tld",
+				`citrixadc_partition_name`: "bu_finance",
+				`citrixadc_partition`:      "bu_retail",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var m dto.Metric
+			if err := (partitionMetric{Metric: tc.metric, partition: "bu_retail"}).Write(&m); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(m.Label) != len(tc.expected) {
+				t.Errorf("expected %d labels, got %d", len(tc.expected), len(m.Label))
+			}
+			for i, l := range m.Label {
+				if tc.expected[l.GetName()] != l.GetValue() {
+					t.Errorf("expected %s to be %q, got %q", l.GetName(), tc.expected[l.GetName()], l.GetValue())
+				}
+				if i > 0 && m.Label[i-1].GetName() > l.GetName() {
+					t.Errorf("labels are not sorted")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// policyTypes are the policy types which hits are exported for.  The Nitro stat resource is the type suffixed with "policy".
+// Bot policies only exist on 13.0 and later, so "bot" is skipped on older firmware, which returns a Nitro error for it
+var policyTypes = []string{"responder", "rewrite", "cs", "cache", "appfw", "authentication", "bot"}
+
+// policyStats represents the data returned from the /stat/<type>policy Nitro API endpoints
+type policyStats struct {
+	Name         string      `json:"name"`
+	Hits         json.Number `json:"pipolicyhits"`
+	UndefHits    json.Number `json:"pipolicyundefhits"`
+	CSPolicyHits json.Number `json:"cspolicyhits"`
+}
+
+// policyBindings represents the data returned from the /config/<type>policy_binding Nitro API endpoints.
+// Each bind point type is returned under its own key, e.g. rewritepolicy_lbvserver_binding, so they are gathered up by UnmarshalJSON
+type policyBindings struct {
+	Name       string
+	BindPoints []string
+}
+
+// UnmarshalJSON collects the bind points from every <type>policy_*_binding array
+func (p *policyBindings) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	for k, v := range raw {
+		if k == "name" {
+			if err := json.Unmarshal(v, &p.Name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !strings.HasSuffix(k, "_binding") {
+			continue
+		}
+
+		var bindings []struct {
+			BoundTo string `json:"boundto"`
+		}
+		if err := json.Unmarshal(v, &bindings); err != nil {
+			return err
+		}
+
+		for _, b := range bindings {
+			p.BindPoints = append(p.BindPoints, b.BoundTo)
+		}
+	}
+
+	return nil
+}
+
+// policy is a single policy with its hits and the bind points it is bound to
+type policy struct {
+	policyType string
+	name       string
+	bindPoints []string
+	hits       json.Number
+	undefHits  json.Number
+}
+
+// getPolicies queries the Nitro API for the stats and bindings of the given policy type, adding them to ns
+func getPolicies(c *netscaler.NitroClient, policyType string, ns *nitroResponse) error {
+	stats, err := c.GetStats(policyType+"policy", "")
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(stats, ns)
+	if err != nil {
+		return errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	bindings, err := c.GetConfig(policyType+"policy_binding", "bulkbindings=yes")
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(bindings, ns)
+	if err != nil {
+		return errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	return nil
+}
+
+// policies joins the policy stats to their bindings.  Hits are only reported per policy, not per bind point
+func (ns nitroResponse) policies() []policy {
+	var policies []policy
+	policies = append(policies, joinPolicies("responder", ns.ResponderPolicyStats, ns.ResponderPolicyBindings)...)
+	policies = append(policies, joinPolicies("rewrite", ns.RewritePolicyStats, ns.RewritePolicyBindings)...)
+	policies = append(policies, joinPolicies("cs", ns.CSPolicyStats, ns.CSPolicyBindings)...)
+	policies = append(policies, joinPolicies("cache", ns.CachePolicyStats, ns.CachePolicyBindings)...)
+	policies = append(policies, joinPolicies("appfw", ns.AppFWPolicyStats, ns.AppFWPolicyBindings)...)
+	policies = append(policies, joinPolicies("authentication", ns.AuthenticationPolicyStats, ns.AuthenticationPolicyBindings)...)
+	policies = append(policies, joinPolicies("bot", ns.BotPolicyStats, ns.BotPolicyBindings)...)
+	return policies
+}
+
+func joinPolicies(policyType string, stats []policyStats, bindings []policyBindings) []policy {
+	bindPoints := make(map[string][]string)
+	for _, b := range bindings {
+		bindPoints[b.Name] = append(bindPoints[b.Name], b.BindPoints...)
+	}
+
+	var policies []policy
+	for _, s := range stats {
+		hits := s.Hits
+		if hits == "" {
+			hits = s.CSPolicyHits
+		}
+
+		bound := bindPoints[s.Name]
+		sort.Strings(bound)
+
+		policies = append(policies, policy{
+			policyType: policyType,
+			name:       s.Name,
+			bindPoints: bound,
+			hits:       hits,
+			undefHits:  s.UndefHits,
+		})
+	}
+	return policies
+}
+
+const policySubsystem = "policy"
+
+var policyLabels = []string{
+	netscalerInstance,
+	`citrixadc_policy_type`,
+	`citrixadc_policy_name`,
+}
+
+var policyBindingLabels = []string{
+	netscalerInstance,
+	`citrixadc_policy_type`,
+	`citrixadc_policy_name`,
+	`citrixadc_policy_bind_point`,
+}
+
+var (
+	policyHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "hits_total",
+			Help:      "Number of requests which matched the policy",
+		},
+		policyLabels,
+	)
+
+	policyUndefHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "undefined_hits_total",
+			Help:      "Number of times the policy rule evaluated to UNDEF, for example because of an error in the expression",
+		},
+		policyLabels,
+	)
+
+	policyBindingInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: policySubsystem,
+			Name:      "binding_info",
+			Help:      "Bind point the policy is bound to, one series per bind point.  Always 1.",
+		},
+		policyBindingLabels,
+	)
+)
+
+func (e *Exporter) collectPolicyHits(ns nitroResponse) {
+	e.policyHits.Reset()
+
+	for _, p := range ns.policies() {
+		val, _ := p.hits.Float64()
+		e.policyHits.WithLabelValues(e.nsInstance, p.policyType, p.name).Set(val)
+	}
+}
+
+func (e *Exporter) collectPolicyUndefHits(ns nitroResponse) {
+	e.policyUndefHits.Reset()
+
+	for _, p := range ns.policies() {
+		// CS policies have no undefined hits counter
+		if p.undefHits == "" {
+			continue
+		}
+		val, _ := p.undefHits.Float64()
+		e.policyUndefHits.WithLabelValues(e.nsInstance, p.policyType, p.name).Set(val)
+	}
+}
+
+func (e *Exporter) collectPolicyBindingInfo(ns nitroResponse) {
+	e.policyBindingInfo.Reset()
+
+	for _, p := range ns.policies() {
+		for _, b := range p.bindPoints {
+			e.policyBindingInfo.WithLabelValues(e.nsInstance, p.policyType, p.name, b).Set(1)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPolicyBindingsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []policyBindings
+	}{
+		{
+			name: "rewrite policy bound to lb and cs vservers",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "rewritepolicy_binding": [ { "name": "rw_pol_add_hsts",
+				"rewritepolicy_lbvserver_binding": [ { "name": "rw_pol_add_hsts", "boundto": "lb_vs_web", "priority": "100", "activepolicy": 1, "gotopriorityexpression": "END", "labeltype": "reqvserver", "labelname": "lb_vs_web" } ],
+				"rewritepolicy_csvserver_binding": [ { "name": "rw_pol_add_hsts", "boundto": "cs_vs_web", "priority": "110", "activepolicy": 1, "gotopriorityexpression": "NEXT", "labeltype": "resvserver", "labelname": "cs_vs_web" } ] } ] }`,
+			expected: []policyBindings{
+				{Name: "rw_pol_add_hsts", BindPoints: []string{"cs_vs_web", "lb_vs_web"}},
+			},
+		},
+		{
+			name: "responder policy bound globally",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "responderpolicy_binding": [ { "name": "rs_pol_block_bad_ip",
+				"responderpolicy_responderglobal_binding": [ { "name": "rs_pol_block_bad_ip", "boundto": "REQ_DEFAULT", "priority": "10", "activepolicy": 1, "gotopriorityexpression": "END", "labeltype": "none", "labelname": "" } ] } ] }`,
+			expected: []policyBindings{
+				{Name: "rs_pol_block_bad_ip", BindPoints: []string{"REQ_DEFAULT"}},
+			},
+		},
+		{
+			name: "unbound cache policy",
+			body: `{ "errorcode": 0, "message": "Done", "severity": "NONE", "cachepolicy_binding": [ { "name": "cache_pol_images" } ] }`,
+			expected: []policyBindings{
+				{Name: "cache_pol_images"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ns nitroResponse
+			if err := json.Unmarshal([]byte(tc.body), &ns); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []policyBindings
+			got = append(got, ns.RewritePolicyBindings...)
+			got = append(got, ns.ResponderPolicyBindings...)
+			got = append(got, ns.CachePolicyBindings...)
+
+			// The bind point keys are unordered, so the bind points are sorted before comparing
+			for i := range got {
+				sort.Strings(got[i].BindPoints)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPolicyBindingsUnmarshalJSONInvalid(t *testing.T) {
+	var p policyBindings
+	body := `{ "name": "rw_pol_add_hsts", "rewritepolicy_lbvserver_binding": { "boundto": "lb_vs_web" } }`
+	if err := json.Unmarshal([]byte(body), &p); err == nil {
+		t.Error("expected an error for a binding which isn't an array")
+	}
+}
@@ -0,0 +1,1578 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// protocolTCPStats represents the data returned from the /stat/protocoltcp Nitro API endpoint
+type protocolTCPStats struct {
+	RxPackets                json.Number `json:"tcptotrxpkts"`
+	RxBytes                  json.Number `json:"tcptotrxbytes"`
+	TxPackets                json.Number `json:"tcptottxpkts"`
+	TxBytes                  json.Number `json:"tcptottxbytes"`
+	CurClientConn            json.Number `json:"tcpcurclientconn"`
+	CurClientConnEstablished json.Number `json:"tcpcurclientconnestablished"`
+	CurServerConn            json.Number `json:"tcpcurserverconn"`
+	CurServerConnEstablished json.Number `json:"tcpcurserverconnestablished"`
+	SurgeQueueLen            json.Number `json:"tcpsurgequeuelen"`
+	SpareConn                json.Number `json:"tcpspareconn"`
+	TotSyn                   json.Number `json:"tcptotsyn"`
+	TotSynProbe              json.Number `json:"tcptotsynprobe"`
+	TotSynHeld               json.Number `json:"tcptotsynheld"`
+	ErrSynDroppedCongestion  json.Number `json:"tcperrsyndroppedcongestion"`
+	ErrSynRetry              json.Number `json:"tcperrsynretry"`
+	ErrSynGiveUp             json.Number `json:"tcperrsyngiveup"`
+	ErrCookiePktSeqReject    json.Number `json:"tcperrcookiepktseqreject"`
+	ErrCookiePktSigReject    json.Number `json:"tcperrcookiepktsigreject"`
+	ErrCookiePktSeqDrop      json.Number `json:"tcperrcookiepktseqdrop"`
+	ErrCookiePktMSSReject    json.Number `json:"tcperrcookiepktmssreject"`
+	ErrRetransmit            json.Number `json:"tcperrretransmit"`
+	ErrFirstRetransmissions  json.Number `json:"tcperrfirstretransmissions"`
+	ErrCltRetransmit         json.Number `json:"tcperrcltretrasmit"`
+	ErrSvrRetransmit         json.Number `json:"tcperrsvrretrasmit"`
+	ErrRetransmitGiveUp      json.Number `json:"tcperrretransmitgiveup"`
+	ErrRst                   json.Number `json:"tcperrrst"`
+	ErrSentRst               json.Number `json:"tcperrsentrst"`
+	ErrRstOutOfWindow        json.Number `json:"tcperrrstoutofwindow"`
+	ErrRstNonEst             json.Number `json:"tcperrrstnonest"`
+	ErrBadChecksum           json.Number `json:"tcperrbadchecksum"`
+	ErrStrayPkt              json.Number `json:"tcperrstraypkt"`
+	ErrCltOutOfOrder         json.Number `json:"tcperrcltoutoforder"`
+	ErrSvrOutOfOrder         json.Number `json:"tcperrsvroutoforder"`
+}
+
+// protocolHTTPStats represents the data returned from the /stat/protocolhttp Nitro API endpoint
+type protocolHTTPStats struct {
+	TotRequests            json.Number `json:"httptotrequests"`
+	TotResponses           json.Number `json:"httptotresponses"`
+	TotGets                json.Number `json:"httptotgets"`
+	TotPosts               json.Number `json:"httptotposts"`
+	TotOthers              json.Number `json:"httptotothers"`
+	TotRxRequestBytes      json.Number `json:"httptotrxrequestbytes"`
+	TotRxResponseBytes     json.Number `json:"httptotrxresponsebytes"`
+	Tot10Requests          json.Number `json:"httptot10requests"`
+	Tot11Requests          json.Number `json:"httptot11requests"`
+	TotChunkedRequests     json.Number `json:"httptotchunkedrequests"`
+	TotChunkedResponses    json.Number `json:"httptotchunkedresponses"`
+	ErrIncompleteRequests  json.Number `json:"httperrincompleterequests"`
+	ErrIncompleteResponses json.Number `json:"httperrincompleteresponses"`
+	ErrIncompleteHeaders   json.Number `json:"httperrincompleteheaders"`
+	ErrLargeContent        json.Number `json:"httperrlargecontent"`
+	ErrLargeChunk          json.Number `json:"httperrlargechunk"`
+	ErrLargeCtlen          json.Number `json:"httperrlargectlen"`
+	ErrServerBusy          json.Number `json:"httperrserverbusy"`
+}
+
+// protocolIPStats represents the data returned from the /stat/protocolip Nitro API endpoint
+type protocolIPStats struct {
+	TotRxPkts            json.Number `json:"iptotrxpkts"`
+	TotRxBytes           json.Number `json:"iptotrxbytes"`
+	TotTxPkts            json.Number `json:"iptottxpkts"`
+	TotTxBytes           json.Number `json:"iptottxbytes"`
+	TotFragments         json.Number `json:"iptotfragments"`
+	TotReassemblyAttempt json.Number `json:"iptotreassemblyattempt"`
+	TotSuccReassembly    json.Number `json:"iptotsuccreassembly"`
+	TotUnsuccReassembly  json.Number `json:"iptotunsuccreassembly"`
+	TotTooBig            json.Number `json:"iptottoobig"`
+	TotDupFragments      json.Number `json:"iptotdupfragments"`
+	TotOutOfOrderFrag    json.Number `json:"iptotoutoforderfrag"`
+	TotZeroFragmentLen   json.Number `json:"iptotzerofragmentlen"`
+	TotBadChecksums      json.Number `json:"iptotbadchecksums"`
+	TotTTLExpired        json.Number `json:"iptotttlexpired"`
+	TotAddrLookup        json.Number `json:"iptotaddrlookup"`
+	TotAddrLookupFail    json.Number `json:"iptotaddrlookupfail"`
+}
+
+// protocolUDPStats represents the data returned from the /stat/protocoludp Nitro API endpoint
+type protocolUDPStats struct {
+	TotRxPkts               json.Number `json:"udptotrxpkts"`
+	TotRxBytes              json.Number `json:"udptotrxbytes"`
+	TotTxPkts               json.Number `json:"udptottxpkts"`
+	TotTxBytes              json.Number `json:"udptottxbytes"`
+	TotUnknownSvcPkts       json.Number `json:"udptotunknownsvcpkts"`
+	BadChecksum             json.Number `json:"udpbadchecksum"`
+	CurRateThresholdExceeds json.Number `json:"udpcurratethresholdexceeds"`
+}
+
+// protocolICMPStats represents the data returned from the /stat/protocolicmp Nitro API endpoint
+type protocolICMPStats struct {
+	TotRxPkts           json.Number `json:"icmptotrxpkts"`
+	TotRxBytes          json.Number `json:"icmptotrxbytes"`
+	TotTxPkts           json.Number `json:"icmptottxpkts"`
+	TotTxBytes          json.Number `json:"icmptottxbytes"`
+	TotRxEcho           json.Number `json:"icmptotrxecho"`
+	TotTxEchoReply      json.Number `json:"icmptottxechoreply"`
+	TotTxEcho           json.Number `json:"icmptottxecho"`
+	TotRxEchoReply      json.Number `json:"icmptotrxechoreply"`
+	TotThresholdExceeds json.Number `json:"icmptotthresholdexceeds"`
+	TotBadChecksum      json.Number `json:"icmptotbadchecksum"`
+}
+
+// getProtocolTCPStats queries the Nitro API for TCP protocol stats
+func getProtocolTCPStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "protocoltcp", querystring)
+}
+
+// getProtocolHTTPStats queries the Nitro API for HTTP protocol stats
+func getProtocolHTTPStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "protocolhttp", querystring)
+}
+
+// getProtocolIPStats queries the Nitro API for IP protocol stats
+func getProtocolIPStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "protocolip", querystring)
+}
+
+// getProtocolUDPStats queries the Nitro API for UDP protocol stats
+func getProtocolUDPStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "protocoludp", querystring)
+}
+
+// getProtocolICMPStats queries the Nitro API for ICMP protocol stats
+func getProtocolICMPStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "protocolicmp", querystring)
+}
+
+const protocolTCPSubsystem = "protocol_tcp"
+const protocolHTTPSubsystem = "protocol_http"
+const protocolIPSubsystem = "protocol_ip"
+const protocolUDPSubsystem = "protocol_udp"
+const protocolICMPSubsystem = "protocol_icmp"
+
+var protocolLabels = []string{
+	netscalerInstance,
+}
+
+var (
+	protocolTCPRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rx_packets_total",
+			Help:      "Total number of TCP packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rx_bytes_total",
+			Help:      "Total number of bytes of TCP data received",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "tx_packets_total",
+			Help:      "Total number of TCP packets transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "tx_bytes_total",
+			Help:      "Total number of bytes of TCP data transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPClientConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "client_connections",
+			Help:      "Client connections, including those opening, established and closing",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPClientConnectionsEstablished = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "client_connections_established",
+			Help:      "Client connections in the established state",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPServerConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "server_connections",
+			Help:      "Server connections, including those opening, established and closing",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPServerConnectionsEstablished = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "server_connections_established",
+			Help:      "Server connections in the established state",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSurgeQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "surge_queue_length",
+			Help:      "Connections in the surge queue",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSpareConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "spare_connections",
+			Help:      "Spare connections available for reuse",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSyn = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_total",
+			Help:      "Total number of SYN packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_probes_total",
+			Help:      "Total number of probes sent in response to SYN packets, as part of SYN flood protection",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynHeld = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_held_total",
+			Help:      "Total number of SYN packets held while waiting for a server connection",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynDroppedCongestion = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_dropped_congestion_total",
+			Help:      "Total number of SYN packets dropped because of network congestion, for example during a SYN flood",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_retries_total",
+			Help:      "Total number of SYN packets resent to a server",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynGiveUps = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_giveups_total",
+			Help:      "Total number of times connection establishment was abandoned after retrying the SYN",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynCookieSeqRejects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_cookie_seq_rejects_total",
+			Help:      "Total number of SYN cookie ACKs rejected because of an invalid sequence number",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynCookieSignatureRejects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_cookie_signature_rejects_total",
+			Help:      "Total number of SYN cookie ACKs rejected because of an invalid signature",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynCookieSeqDrops = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_cookie_seq_drops_total",
+			Help:      "Total number of SYN cookie ACKs dropped because of an invalid sequence number",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPSynCookieMSSRejects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "syn_cookie_mss_rejects_total",
+			Help:      "Total number of SYN cookie ACKs rejected because of an invalid MSS",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRetransmits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "retransmits_total",
+			Help:      "Total number of TCP packets retransmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPFirstRetransmits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "first_retransmits_total",
+			Help:      "Total number of packets retransmitted for the first time",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPClientRetransmits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "client_retransmits_total",
+			Help:      "Total number of packets retransmitted by clients",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPServerRetransmits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "server_retransmits_total",
+			Help:      "Total number of packets retransmitted by servers",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRetransmitGiveUps = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "retransmit_giveups_total",
+			Help:      "Total number of times the NetScaler terminated a connection after retransmitting a packet the maximum number of times",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRstReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rst_received_total",
+			Help:      "Total number of RST packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRstSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rst_sent_total",
+			Help:      "Total number of RST packets sent",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRstOutOfWindow = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rst_out_of_window_total",
+			Help:      "Total number of RST packets received with a sequence number outside the window",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPRstNonEstablished = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "rst_non_established_total",
+			Help:      "Total number of RST packets received on connections which were not established",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPBadChecksums = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "bad_checksums_total",
+			Help:      "Total number of TCP packets received with a bad checksum",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPStrayPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "stray_packets_total",
+			Help:      "Total number of TCP packets received which did not belong to any connection",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPClientOutOfOrder = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "client_out_of_order_total",
+			Help:      "Total number of out of order packets received from clients",
+		},
+		protocolLabels,
+	)
+
+	protocolTCPServerOutOfOrder = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolTCPSubsystem,
+			Name:      "server_out_of_order_total",
+			Help:      "Total number of out of order packets received from servers",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of HTTP responses sent",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPGetRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "get_requests_total",
+			Help:      "Total number of HTTP GET requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPPostRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "post_requests_total",
+			Help:      "Total number of HTTP POST requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPOtherRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "other_requests_total",
+			Help:      "Total number of HTTP requests received with a method other than GET or POST",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPRequestBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "request_bytes_total",
+			Help:      "Total number of bytes of HTTP request data received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPResponseBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total number of bytes of HTTP response data received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPHTTP10Requests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "http10_requests_total",
+			Help:      "Total number of HTTP/1.0 requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPHTTP11Requests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "http11_requests_total",
+			Help:      "Total number of HTTP/1.1 requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPChunkedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "chunked_requests_total",
+			Help:      "Total number of HTTP requests received with chunked transfer encoding",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPChunkedResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "chunked_responses_total",
+			Help:      "Total number of HTTP responses sent with chunked transfer encoding",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPIncompleteRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "incomplete_requests_total",
+			Help:      "Total number of HTTP requests received with an incomplete header",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPIncompleteResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "incomplete_responses_total",
+			Help:      "Total number of HTTP responses received with an incomplete header",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPIncompleteHeaders = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "incomplete_headers_total",
+			Help:      "Total number of HTTP requests and responses received with the header spanning more than one packet",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPLargeContent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "large_content_total",
+			Help:      "Total number of HTTP requests and responses received with a content length larger than allowed",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPLargeChunk = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "large_chunk_total",
+			Help:      "Total number of HTTP requests and responses received with a chunk larger than allowed",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPInvalidContentLength = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "invalid_content_length_total",
+			Help:      "Total number of HTTP requests and responses received with an invalid content length",
+		},
+		protocolLabels,
+	)
+
+	protocolHTTPServerBusy = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolHTTPSubsystem,
+			Name:      "server_busy_errors_total",
+			Help:      "Total number of HTTP error responses received from servers indicating they were busy",
+		},
+		protocolLabels,
+	)
+
+	protocolIPRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "rx_packets_total",
+			Help:      "Total number of IP packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolIPRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "rx_bytes_total",
+			Help:      "Total number of bytes of IP data received",
+		},
+		protocolLabels,
+	)
+
+	protocolIPTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "tx_packets_total",
+			Help:      "Total number of IP packets transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolIPTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "tx_bytes_total",
+			Help:      "Total number of bytes of IP data transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolIPFragments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "fragments_total",
+			Help:      "Total number of IP fragments received",
+		},
+		protocolLabels,
+	)
+
+	protocolIPReassemblyAttempts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "reassembly_attempts_total",
+			Help:      "Total number of IP packets the NetScaler attempted to reassemble from fragments",
+		},
+		protocolLabels,
+	)
+
+	protocolIPReassembled = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "reassembled_total",
+			Help:      "Total number of IP packets successfully reassembled from fragments",
+		},
+		protocolLabels,
+	)
+
+	protocolIPReassemblyFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "reassembly_failures_total",
+			Help:      "Total number of IP packets which could not be reassembled from fragments",
+		},
+		protocolLabels,
+	)
+
+	protocolIPTooBig = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "too_big_total",
+			Help:      "Total number of IP packets which exceeded the maximum size once reassembled",
+		},
+		protocolLabels,
+	)
+
+	protocolIPDuplicateFragments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "duplicate_fragments_total",
+			Help:      "Total number of duplicate IP fragments received",
+		},
+		protocolLabels,
+	)
+
+	protocolIPOutOfOrderFragments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "out_of_order_fragments_total",
+			Help:      "Total number of IP fragments received out of order",
+		},
+		protocolLabels,
+	)
+
+	protocolIPZeroLengthFragments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "zero_length_fragments_total",
+			Help:      "Total number of IP fragments received with a length of zero",
+		},
+		protocolLabels,
+	)
+
+	protocolIPBadChecksums = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "bad_checksums_total",
+			Help:      "Total number of IP packets received with a bad checksum",
+		},
+		protocolLabels,
+	)
+
+	protocolIPTTLExpired = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "ttl_expired_total",
+			Help:      "Total number of IP packets dropped because their TTL expired",
+		},
+		protocolLabels,
+	)
+
+	protocolIPAddressLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "address_lookups_total",
+			Help:      "Total number of IP address lookups performed",
+		},
+		protocolLabels,
+	)
+
+	protocolIPAddressLookupFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolIPSubsystem,
+			Name:      "address_lookup_failures_total",
+			Help:      "Total number of IP address lookups which failed",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "rx_packets_total",
+			Help:      "Total number of UDP packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "rx_bytes_total",
+			Help:      "Total number of bytes of UDP data received",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "tx_packets_total",
+			Help:      "Total number of UDP packets transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "tx_bytes_total",
+			Help:      "Total number of bytes of UDP data transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPUnknownServicePackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "unknown_service_packets_total",
+			Help:      "Total number of UDP packets received for a service which is not configured",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPBadChecksums = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "bad_checksums_total",
+			Help:      "Total number of UDP packets received with a bad checksum",
+		},
+		protocolLabels,
+	)
+
+	protocolUDPRateThresholdExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolUDPSubsystem,
+			Name:      "rate_threshold_exceeded_total",
+			Help:      "Total number of times the UDP rate threshold was exceeded",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "rx_packets_total",
+			Help:      "Total number of ICMP packets received",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "rx_bytes_total",
+			Help:      "Total number of bytes of ICMP data received",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "tx_packets_total",
+			Help:      "Total number of ICMP packets transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "tx_bytes_total",
+			Help:      "Total number of bytes of ICMP data transmitted",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPEchoRequestsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "echo_requests_received_total",
+			Help:      "Total number of ICMP echo requests received",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPEchoRepliesSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "echo_replies_sent_total",
+			Help:      "Total number of ICMP echo replies sent",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPEchoRequestsSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "echo_requests_sent_total",
+			Help:      "Total number of ICMP echo requests sent",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPEchoRepliesReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "echo_replies_received_total",
+			Help:      "Total number of ICMP echo replies received",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPRateThresholdExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "rate_threshold_exceeded_total",
+			Help:      "Total number of times the ICMP rate threshold was exceeded",
+		},
+		protocolLabels,
+	)
+
+	protocolICMPBadChecksums = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: protocolICMPSubsystem,
+			Name:      "bad_checksums_total",
+			Help:      "Total number of ICMP packets received with a bad checksum",
+		},
+		protocolLabels,
+	)
+)
+
+func (e *Exporter) collectProtocolTCPRxPackets(ns nitroResponse) {
+	e.protocolTCPRxPackets.Reset()
+
+	val, _ := ns.ProtocolTCPStats.RxPackets.Float64()
+	e.protocolTCPRxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRxBytes(ns nitroResponse) {
+	e.protocolTCPRxBytes.Reset()
+
+	val, _ := ns.ProtocolTCPStats.RxBytes.Float64()
+	e.protocolTCPRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPTxPackets(ns nitroResponse) {
+	e.protocolTCPTxPackets.Reset()
+
+	val, _ := ns.ProtocolTCPStats.TxPackets.Float64()
+	e.protocolTCPTxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPTxBytes(ns nitroResponse) {
+	e.protocolTCPTxBytes.Reset()
+
+	val, _ := ns.ProtocolTCPStats.TxBytes.Float64()
+	e.protocolTCPTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPClientConnections(ns nitroResponse) {
+	e.protocolTCPClientConnections.Reset()
+
+	val, _ := ns.ProtocolTCPStats.CurClientConn.Float64()
+	e.protocolTCPClientConnections.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPClientConnectionsEstablished(ns nitroResponse) {
+	e.protocolTCPClientConnectionsEstablished.Reset()
+
+	val, _ := ns.ProtocolTCPStats.CurClientConnEstablished.Float64()
+	e.protocolTCPClientConnectionsEstablished.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPServerConnections(ns nitroResponse) {
+	e.protocolTCPServerConnections.Reset()
+
+	val, _ := ns.ProtocolTCPStats.CurServerConn.Float64()
+	e.protocolTCPServerConnections.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPServerConnectionsEstablished(ns nitroResponse) {
+	e.protocolTCPServerConnectionsEstablished.Reset()
+
+	val, _ := ns.ProtocolTCPStats.CurServerConnEstablished.Float64()
+	e.protocolTCPServerConnectionsEstablished.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSurgeQueueLength(ns nitroResponse) {
+	e.protocolTCPSurgeQueueLength.Reset()
+
+	val, _ := ns.ProtocolTCPStats.SurgeQueueLen.Float64()
+	e.protocolTCPSurgeQueueLength.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSpareConnections(ns nitroResponse) {
+	e.protocolTCPSpareConnections.Reset()
+
+	val, _ := ns.ProtocolTCPStats.SpareConn.Float64()
+	e.protocolTCPSpareConnections.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSyn(ns nitroResponse) {
+	e.protocolTCPSyn.Reset()
+
+	val, _ := ns.ProtocolTCPStats.TotSyn.Float64()
+	e.protocolTCPSyn.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynProbes(ns nitroResponse) {
+	e.protocolTCPSynProbes.Reset()
+
+	val, _ := ns.ProtocolTCPStats.TotSynProbe.Float64()
+	e.protocolTCPSynProbes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynHeld(ns nitroResponse) {
+	e.protocolTCPSynHeld.Reset()
+
+	val, _ := ns.ProtocolTCPStats.TotSynHeld.Float64()
+	e.protocolTCPSynHeld.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynDroppedCongestion(ns nitroResponse) {
+	e.protocolTCPSynDroppedCongestion.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSynDroppedCongestion.Float64()
+	e.protocolTCPSynDroppedCongestion.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynRetries(ns nitroResponse) {
+	e.protocolTCPSynRetries.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSynRetry.Float64()
+	e.protocolTCPSynRetries.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynGiveUps(ns nitroResponse) {
+	e.protocolTCPSynGiveUps.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSynGiveUp.Float64()
+	e.protocolTCPSynGiveUps.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynCookieSeqRejects(ns nitroResponse) {
+	e.protocolTCPSynCookieSeqRejects.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCookiePktSeqReject.Float64()
+	e.protocolTCPSynCookieSeqRejects.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynCookieSignatureRejects(ns nitroResponse) {
+	e.protocolTCPSynCookieSignatureRejects.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCookiePktSigReject.Float64()
+	e.protocolTCPSynCookieSignatureRejects.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynCookieSeqDrops(ns nitroResponse) {
+	e.protocolTCPSynCookieSeqDrops.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCookiePktSeqDrop.Float64()
+	e.protocolTCPSynCookieSeqDrops.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPSynCookieMSSRejects(ns nitroResponse) {
+	e.protocolTCPSynCookieMSSRejects.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCookiePktMSSReject.Float64()
+	e.protocolTCPSynCookieMSSRejects.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRetransmits(ns nitroResponse) {
+	e.protocolTCPRetransmits.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrRetransmit.Float64()
+	e.protocolTCPRetransmits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPFirstRetransmits(ns nitroResponse) {
+	e.protocolTCPFirstRetransmits.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrFirstRetransmissions.Float64()
+	e.protocolTCPFirstRetransmits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPClientRetransmits(ns nitroResponse) {
+	e.protocolTCPClientRetransmits.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCltRetransmit.Float64()
+	e.protocolTCPClientRetransmits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPServerRetransmits(ns nitroResponse) {
+	e.protocolTCPServerRetransmits.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSvrRetransmit.Float64()
+	e.protocolTCPServerRetransmits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRetransmitGiveUps(ns nitroResponse) {
+	e.protocolTCPRetransmitGiveUps.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrRetransmitGiveUp.Float64()
+	e.protocolTCPRetransmitGiveUps.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRstReceived(ns nitroResponse) {
+	e.protocolTCPRstReceived.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrRst.Float64()
+	e.protocolTCPRstReceived.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRstSent(ns nitroResponse) {
+	e.protocolTCPRstSent.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSentRst.Float64()
+	e.protocolTCPRstSent.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRstOutOfWindow(ns nitroResponse) {
+	e.protocolTCPRstOutOfWindow.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrRstOutOfWindow.Float64()
+	e.protocolTCPRstOutOfWindow.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPRstNonEstablished(ns nitroResponse) {
+	e.protocolTCPRstNonEstablished.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrRstNonEst.Float64()
+	e.protocolTCPRstNonEstablished.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPBadChecksums(ns nitroResponse) {
+	e.protocolTCPBadChecksums.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrBadChecksum.Float64()
+	e.protocolTCPBadChecksums.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPStrayPackets(ns nitroResponse) {
+	e.protocolTCPStrayPackets.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrStrayPkt.Float64()
+	e.protocolTCPStrayPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPClientOutOfOrder(ns nitroResponse) {
+	e.protocolTCPClientOutOfOrder.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrCltOutOfOrder.Float64()
+	e.protocolTCPClientOutOfOrder.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolTCPServerOutOfOrder(ns nitroResponse) {
+	e.protocolTCPServerOutOfOrder.Reset()
+
+	val, _ := ns.ProtocolTCPStats.ErrSvrOutOfOrder.Float64()
+	e.protocolTCPServerOutOfOrder.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPRequests(ns nitroResponse) {
+	e.protocolHTTPRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotRequests.Float64()
+	e.protocolHTTPRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPResponses(ns nitroResponse) {
+	e.protocolHTTPResponses.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotResponses.Float64()
+	e.protocolHTTPResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPGetRequests(ns nitroResponse) {
+	e.protocolHTTPGetRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotGets.Float64()
+	e.protocolHTTPGetRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPPostRequests(ns nitroResponse) {
+	e.protocolHTTPPostRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotPosts.Float64()
+	e.protocolHTTPPostRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPOtherRequests(ns nitroResponse) {
+	e.protocolHTTPOtherRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotOthers.Float64()
+	e.protocolHTTPOtherRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPRequestBytes(ns nitroResponse) {
+	e.protocolHTTPRequestBytes.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotRxRequestBytes.Float64()
+	e.protocolHTTPRequestBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPResponseBytes(ns nitroResponse) {
+	e.protocolHTTPResponseBytes.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotRxResponseBytes.Float64()
+	e.protocolHTTPResponseBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPHTTP10Requests(ns nitroResponse) {
+	e.protocolHTTPHTTP10Requests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.Tot10Requests.Float64()
+	e.protocolHTTPHTTP10Requests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPHTTP11Requests(ns nitroResponse) {
+	e.protocolHTTPHTTP11Requests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.Tot11Requests.Float64()
+	e.protocolHTTPHTTP11Requests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPChunkedRequests(ns nitroResponse) {
+	e.protocolHTTPChunkedRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotChunkedRequests.Float64()
+	e.protocolHTTPChunkedRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPChunkedResponses(ns nitroResponse) {
+	e.protocolHTTPChunkedResponses.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.TotChunkedResponses.Float64()
+	e.protocolHTTPChunkedResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPIncompleteRequests(ns nitroResponse) {
+	e.protocolHTTPIncompleteRequests.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrIncompleteRequests.Float64()
+	e.protocolHTTPIncompleteRequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPIncompleteResponses(ns nitroResponse) {
+	e.protocolHTTPIncompleteResponses.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrIncompleteResponses.Float64()
+	e.protocolHTTPIncompleteResponses.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPIncompleteHeaders(ns nitroResponse) {
+	e.protocolHTTPIncompleteHeaders.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrIncompleteHeaders.Float64()
+	e.protocolHTTPIncompleteHeaders.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPLargeContent(ns nitroResponse) {
+	e.protocolHTTPLargeContent.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrLargeContent.Float64()
+	e.protocolHTTPLargeContent.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPLargeChunk(ns nitroResponse) {
+	e.protocolHTTPLargeChunk.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrLargeChunk.Float64()
+	e.protocolHTTPLargeChunk.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPInvalidContentLength(ns nitroResponse) {
+	e.protocolHTTPInvalidContentLength.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrLargeCtlen.Float64()
+	e.protocolHTTPInvalidContentLength.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolHTTPServerBusy(ns nitroResponse) {
+	e.protocolHTTPServerBusy.Reset()
+
+	val, _ := ns.ProtocolHTTPStats.ErrServerBusy.Float64()
+	e.protocolHTTPServerBusy.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPRxPackets(ns nitroResponse) {
+	e.protocolIPRxPackets.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotRxPkts.Float64()
+	e.protocolIPRxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPRxBytes(ns nitroResponse) {
+	e.protocolIPRxBytes.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotRxBytes.Float64()
+	e.protocolIPRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPTxPackets(ns nitroResponse) {
+	e.protocolIPTxPackets.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotTxPkts.Float64()
+	e.protocolIPTxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPTxBytes(ns nitroResponse) {
+	e.protocolIPTxBytes.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotTxBytes.Float64()
+	e.protocolIPTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPFragments(ns nitroResponse) {
+	e.protocolIPFragments.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotFragments.Float64()
+	e.protocolIPFragments.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPReassemblyAttempts(ns nitroResponse) {
+	e.protocolIPReassemblyAttempts.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotReassemblyAttempt.Float64()
+	e.protocolIPReassemblyAttempts.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPReassembled(ns nitroResponse) {
+	e.protocolIPReassembled.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotSuccReassembly.Float64()
+	e.protocolIPReassembled.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPReassemblyFailures(ns nitroResponse) {
+	e.protocolIPReassemblyFailures.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotUnsuccReassembly.Float64()
+	e.protocolIPReassemblyFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPTooBig(ns nitroResponse) {
+	e.protocolIPTooBig.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotTooBig.Float64()
+	e.protocolIPTooBig.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPDuplicateFragments(ns nitroResponse) {
+	e.protocolIPDuplicateFragments.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotDupFragments.Float64()
+	e.protocolIPDuplicateFragments.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPOutOfOrderFragments(ns nitroResponse) {
+	e.protocolIPOutOfOrderFragments.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotOutOfOrderFrag.Float64()
+	e.protocolIPOutOfOrderFragments.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPZeroLengthFragments(ns nitroResponse) {
+	e.protocolIPZeroLengthFragments.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotZeroFragmentLen.Float64()
+	e.protocolIPZeroLengthFragments.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPBadChecksums(ns nitroResponse) {
+	e.protocolIPBadChecksums.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotBadChecksums.Float64()
+	e.protocolIPBadChecksums.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPTTLExpired(ns nitroResponse) {
+	e.protocolIPTTLExpired.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotTTLExpired.Float64()
+	e.protocolIPTTLExpired.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPAddressLookups(ns nitroResponse) {
+	e.protocolIPAddressLookups.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotAddrLookup.Float64()
+	e.protocolIPAddressLookups.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolIPAddressLookupFailures(ns nitroResponse) {
+	e.protocolIPAddressLookupFailures.Reset()
+
+	val, _ := ns.ProtocolIPStats.TotAddrLookupFail.Float64()
+	e.protocolIPAddressLookupFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPRxPackets(ns nitroResponse) {
+	e.protocolUDPRxPackets.Reset()
+
+	val, _ := ns.ProtocolUDPStats.TotRxPkts.Float64()
+	e.protocolUDPRxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPRxBytes(ns nitroResponse) {
+	e.protocolUDPRxBytes.Reset()
+
+	val, _ := ns.ProtocolUDPStats.TotRxBytes.Float64()
+	e.protocolUDPRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPTxPackets(ns nitroResponse) {
+	e.protocolUDPTxPackets.Reset()
+
+	val, _ := ns.ProtocolUDPStats.TotTxPkts.Float64()
+	e.protocolUDPTxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPTxBytes(ns nitroResponse) {
+	e.protocolUDPTxBytes.Reset()
+
+	val, _ := ns.ProtocolUDPStats.TotTxBytes.Float64()
+	e.protocolUDPTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPUnknownServicePackets(ns nitroResponse) {
+	e.protocolUDPUnknownServicePackets.Reset()
+
+	val, _ := ns.ProtocolUDPStats.TotUnknownSvcPkts.Float64()
+	e.protocolUDPUnknownServicePackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPBadChecksums(ns nitroResponse) {
+	e.protocolUDPBadChecksums.Reset()
+
+	val, _ := ns.ProtocolUDPStats.BadChecksum.Float64()
+	e.protocolUDPBadChecksums.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolUDPRateThresholdExceeded(ns nitroResponse) {
+	e.protocolUDPRateThresholdExceeded.Reset()
+
+	val, _ := ns.ProtocolUDPStats.CurRateThresholdExceeds.Float64()
+	e.protocolUDPRateThresholdExceeded.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPRxPackets(ns nitroResponse) {
+	e.protocolICMPRxPackets.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotRxPkts.Float64()
+	e.protocolICMPRxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPRxBytes(ns nitroResponse) {
+	e.protocolICMPRxBytes.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotRxBytes.Float64()
+	e.protocolICMPRxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPTxPackets(ns nitroResponse) {
+	e.protocolICMPTxPackets.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotTxPkts.Float64()
+	e.protocolICMPTxPackets.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPTxBytes(ns nitroResponse) {
+	e.protocolICMPTxBytes.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotTxBytes.Float64()
+	e.protocolICMPTxBytes.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPEchoRequestsReceived(ns nitroResponse) {
+	e.protocolICMPEchoRequestsReceived.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotRxEcho.Float64()
+	e.protocolICMPEchoRequestsReceived.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPEchoRepliesSent(ns nitroResponse) {
+	e.protocolICMPEchoRepliesSent.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotTxEchoReply.Float64()
+	e.protocolICMPEchoRepliesSent.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPEchoRequestsSent(ns nitroResponse) {
+	e.protocolICMPEchoRequestsSent.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotTxEcho.Float64()
+	e.protocolICMPEchoRequestsSent.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPEchoRepliesReceived(ns nitroResponse) {
+	e.protocolICMPEchoRepliesReceived.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotRxEchoReply.Float64()
+	e.protocolICMPEchoRepliesReceived.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPRateThresholdExceeded(ns nitroResponse) {
+	e.protocolICMPRateThresholdExceeded.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotThresholdExceeds.Float64()
+	e.protocolICMPRateThresholdExceeded.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectProtocolICMPBadChecksums(ns nitroResponse) {
+	e.protocolICMPBadChecksums.Reset()
+
+	val, _ := ns.ProtocolICMPStats.TotBadChecksum.Float64()
+	e.protocolICMPBadChecksums.WithLabelValues(e.nsInstance).Set(val)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// limitIdentifiers represents the data returned from the /stat/nslimitidentifier and /config/nslimitidentifier Nitro API endpoints.
+// Both are returned under the same key, so the stats populate Name and the config populates LimitIdentifier
+type limitIdentifiers struct {
+	Name            string      `json:"name"`
+	Hits            json.Number `json:"ratelmtobjhits"`
+	Drops           json.Number `json:"ratelmtobjdrops"`
+	LimitIdentifier string      `json:"limitidentifier"`
+	Threshold       json.Number `json:"threshold"`
+	Mode            string      `json:"mode"`
+}
+
+// limitSessions represents the data returned from the /config/nslimitsessions Nitro API endpoint when counting the sessions
+type limitSessions struct {
+	LimitIdentifier string      `json:"limitidentifier"`
+	Count           json.Number `json:"__count"`
+}
+
+// getLimitIdentifierStats queries the Nitro API for rate limit identifier stats
+func getLimitIdentifierStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "nslimitidentifier", querystring)
+}
+
+// getLimitIdentifiers queries the Nitro API for rate limit identifier config
+func getLimitIdentifiers(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nslimitidentifier", querystring)
+}
+
+// getLimitSessions queries the Nitro API for the number of active sessions of a rate limit identifier.
+// Only the count is returned, rather than every session, and it's tagged with the limit identifier it was queried for
+func getLimitSessions(c *netscaler.NitroClient, limitIdentifier string) (nitroResponse, error) {
+	sessions, err := getConfig(c, "nslimitsessions", "args=limitidentifier:"+url.QueryEscape(limitIdentifier)+"&count=yes")
+	if err != nil {
+		return sessions, err
+	}
+
+	// Some firmware returns no count at all, rather than a count of 0, when there are no sessions
+	if len(sessions.LimitSessions) == 0 {
+		sessions.LimitSessions = []limitSessions{{Count: "0"}}
+	}
+	for i := range sessions.LimitSessions {
+		sessions.LimitSessions[i].LimitIdentifier = limitIdentifier
+	}
+	return sessions, nil
+}
+
+const limitIdentifiersSubsystem = "limit_identifier"
+
+var limitIdentifiersLabels = []string{
+	netscalerInstance,
+	`citrixadc_limit_identifier`,
+}
+
+var limitIdentifiersThresholdLabels = []string{
+	netscalerInstance,
+	`citrixadc_limit_identifier`,
+	`citrixadc_limit_mode`,
+}
+
+var (
+	limitIdentifiersHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: limitIdentifiersSubsystem,
+			Name:      "hits_total",
+			Help:      "Total number of times the limit identifier was evaluated",
+		},
+		limitIdentifiersLabels,
+	)
+
+	limitIdentifiersDrops = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: limitIdentifiersSubsystem,
+			Name:      "drops_total",
+			Help:      "Total number of times the limit identifier's threshold was exceeded",
+		},
+		limitIdentifiersLabels,
+	)
+
+	limitIdentifiersCurrentSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: limitIdentifiersSubsystem,
+			Name:      "current_sessions",
+			Help:      "Number of active rate limit sessions, one per distinct selector value, tracked by the limit identifier",
+		},
+		limitIdentifiersLabels,
+	)
+
+	limitIdentifiersThreshold = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: limitIdentifiersSubsystem,
+			Name:      "threshold",
+			Help:      "Maximum allowed for each session of the limit identifier.  Requests per time slice in REQUEST_RATE mode, concurrent connections in CONNECTION mode",
+		},
+		limitIdentifiersThresholdLabels,
+	)
+)
+
+func (e *Exporter) collectLimitIdentifiersHits(ns nitroResponse) {
+	e.limitIdentifiersHits.Reset()
+
+	for _, l := range ns.LimitIdentifiers {
+		val, _ := l.Hits.Float64()
+		e.limitIdentifiersHits.WithLabelValues(e.nsInstance, l.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectLimitIdentifiersDrops(ns nitroResponse) {
+	e.limitIdentifiersDrops.Reset()
+
+	for _, l := range ns.LimitIdentifiers {
+		val, _ := l.Drops.Float64()
+		e.limitIdentifiersDrops.WithLabelValues(e.nsInstance, l.Name).Set(val)
+	}
+}
+
+func (e *Exporter) collectLimitIdentifiersCurrentSessions(sessions nitroResponse) {
+	e.limitIdentifiersCurrentSessions.Reset()
+
+	// Limit identifiers whose sessions couldn't be counted have no count, so are left out rather than reported as 0
+	for _, s := range sessions.LimitSessions {
+		val, _ := s.Count.Float64()
+		e.limitIdentifiersCurrentSessions.WithLabelValues(e.nsInstance, s.LimitIdentifier).Set(val)
+	}
+}
+
+func (e *Exporter) collectLimitIdentifiersThreshold(ns nitroResponse) {
+	e.limitIdentifiersThreshold.Reset()
+
+	for _, l := range ns.LimitIdentifiers {
+		val, _ := l.Threshold.Float64()
+		e.limitIdentifiersThreshold.WithLabelValues(e.nsInstance, l.LimitIdentifier, l.Mode).Set(val)
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// servers represents the data returned from the /config/server Nitro API endpoint
+type servers struct {
+	Name      string      `json:"name"`
+	IPAddress string      `json:"ipaddress"`
+	Domain    string      `json:"domain"`
+	TD        json.Number `json:"td"`
+	State     string      `json:"state"`
+}
+
+// serverServiceBindings represents the data returned from the /config/server_service_binding Nitro API endpoint
+type serverServiceBindings struct {
+	Name        string `json:"name"`
+	ServiceName string `json:"servicename"`
+}
+
+// serverServiceGroupBindings represents the data returned from the /config/server_servicegroup_binding Nitro API endpoint
+type serverServiceGroupBindings struct {
+	Name             string `json:"name"`
+	ServiceGroupName string `json:"servicegroupname"`
+}
+
+// getServers queries the Nitro API for server config
+func getServers(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "server", querystring)
+}
+
+// getServerServiceBindings queries the Nitro API for the services bound to each server
+func getServerServiceBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "server_service_binding", querystring)
+}
+
+// getServerServiceGroupBindings queries the Nitro API for the service groups bound to each server
+func getServerServiceGroupBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "server_servicegroup_binding", querystring)
+}
+
+const serversSubsystem = "server"
+
+var serversLabels = []string{
+	netscalerInstance,
+	`citrixadc_server_name`,
+	`citrixadc_traffic_domain`,
+}
+
+var serversDomainLabels = []string{
+	netscalerInstance,
+	`citrixadc_server_name`,
+	`citrixadc_server_domain`,
+	`citrixadc_traffic_domain`,
+}
+
+var (
+	serversState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: serversSubsystem,
+			Name:      "state",
+			Help:      "Current administrative state of the server. 0 = DISABLED, 1 = ENABLED, 2 = GOING OUT OF SERVICE (graceful), 3 = UNKNOWN",
+		},
+		serversLabels,
+	)
+
+	serversDomainIPAddress = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: serversSubsystem,
+			Name:      "domain_ip_address",
+			Help:      "Whether the domain based server currently has an IP address from resolving its domain. 0 = NO IP ADDRESS, 1 = IP ADDRESS",
+		},
+		serversDomainLabels,
+	)
+
+	serversBoundServices = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: serversSubsystem,
+			Name:      "bound_services",
+			Help:      "Number of services bound to the server",
+		},
+		serversLabels,
+	)
+
+	serversBoundServiceGroups = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: serversSubsystem,
+			Name:      "bound_servicegroups",
+			Help:      "Number of service groups the server is a member of",
+		},
+		serversLabels,
+	)
+)
+
+func (e *Exporter) collectServersState(ns nitroResponse) {
+	e.serversState.Reset()
+
+	for _, s := range ns.Servers {
+		var state float64
+		switch s.State {
+		case `DISABLED`:
+			state = 0.0
+		case `ENABLED`:
+			state = 1.0
+		case `GOING OUT OF SERVICE`:
+			state = 2.0
+		default:
+			state = 3.0
+		}
+		e.serversState.WithLabelValues(e.nsInstance, s.Name, s.trafficDomain()).Set(state)
+	}
+}
+
+func (e *Exporter) collectServersDomainIPAddress(ns nitroResponse) {
+	e.serversDomainIPAddress.Reset()
+
+	for _, s := range ns.Servers {
+		// IP address based servers have nothing to resolve
+		if s.Domain == "" {
+			continue
+		}
+		var hasIPAddress float64
+		if s.IPAddress != "" {
+			hasIPAddress = 1.0
+		}
+		e.serversDomainIPAddress.WithLabelValues(e.nsInstance, s.Name, s.Domain, s.trafficDomain()).Set(hasIPAddress)
+	}
+}
+
+func (e *Exporter) collectServersBoundServices(ns nitroResponse, bindings nitroResponse) {
+	e.serversBoundServices.Reset()
+
+	counts := make(map[string]float64)
+	for _, b := range bindings.ServerServiceBindings {
+		counts[b.Name]++
+	}
+	for _, s := range ns.Servers {
+		e.serversBoundServices.WithLabelValues(e.nsInstance, s.Name, s.trafficDomain()).Set(counts[s.Name])
+	}
+}
+
+func (e *Exporter) collectServersBoundServiceGroups(ns nitroResponse, bindings nitroResponse) {
+	e.serversBoundServiceGroups.Reset()
+
+	counts := make(map[string]float64)
+	for _, b := range bindings.ServerServiceGroupBindings {
+		counts[b.Name]++
+	}
+	for _, s := range ns.Servers {
+		e.serversBoundServiceGroups.WithLabelValues(e.nsInstance, s.Name, s.trafficDomain()).Set(counts[s.Name])
+	}
+}
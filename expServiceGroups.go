@@ -14,6 +14,7 @@ var serviceGroupsLabels = []string{
 	netscalerInstance,
 	`citrixadc_servicegroup_name`,
 	`citrixadc_servicegroup_member`,
+	`citrixadc_traffic_domain`,
 }
 
 var (
@@ -153,7 +154,7 @@ func (e *Exporter) collectServiceGroupsState(sg netscaler.ServiceGroupMemberStat
 		state = 3.0
 	}
 
-	e.serviceGroupsState.WithLabelValues(e.nsInstance, sgName, servername).Set(state)
+	e.serviceGroupsState.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(state)
 }
 
 func (e *Exporter) collectServiceGroupsAvgTTFB(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
@@ -162,75 +163,75 @@ func (e *Exporter) collectServiceGroupsAvgTTFB(sg netscaler.ServiceGroupMemberSt
 	var serviceGroupsAvgTTFBInSeconds float64
 	val, _ := strconv.ParseFloat(sg.AvgTimeToFirstByte, 64)
 	serviceGroupsAvgTTFBInSeconds = val * 0.001
-	e.serviceGroupsAvgTTFB.WithLabelValues(e.nsInstance, sgName, servername).Set(serviceGroupsAvgTTFBInSeconds)
+	e.serviceGroupsAvgTTFB.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(serviceGroupsAvgTTFBInSeconds)
 }
 
 func (e *Exporter) collectServiceGroupsTotalRequests(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsTotalRequests.Reset()
 
 	val, _ := strconv.ParseFloat(sg.TotalRequests, 64)
-	e.serviceGroupsTotalRequests.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsTotalRequests.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsTotalResponses(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsTotalResponses.Reset()
 
 	val, _ := strconv.ParseFloat(sg.TotalResponses, 64)
-	e.serviceGroupsTotalResponses.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsTotalResponses.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsTotalRequestBytes(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsTotalRequestBytes.Reset()
 
 	val, _ := strconv.ParseFloat(sg.TotalRequestBytes, 64)
-	e.serviceGroupsTotalRequestBytes.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsTotalRequestBytes.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsTotalResponseBytes(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsTotalResponseBytes.Reset()
 
 	val, _ := strconv.ParseFloat(sg.TotalResponseBytes, 64)
-	e.serviceGroupsTotalResponseBytes.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsTotalResponseBytes.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsCurrentClientConnections(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsCurrentClientConnections.Reset()
 
 	val, _ := strconv.ParseFloat(sg.CurrentClientConnections, 64)
-	e.serviceGroupsCurrentClientConnections.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsCurrentClientConnections.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsSurgeCount(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsSurgeCount.Reset()
 
 	val, _ := strconv.ParseFloat(sg.SurgeCount, 64)
-	e.serviceGroupsSurgeCount.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsSurgeCount.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsCurrentServerConnections(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsCurrentServerConnections.Reset()
 
 	val, _ := strconv.ParseFloat(sg.CurrentServerConnections, 64)
-	e.serviceGroupsCurrentServerConnections.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsCurrentServerConnections.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsServerEstablishedConnections(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsServerEstablishedConnections.Reset()
 
 	val, _ := strconv.ParseFloat(sg.ServerEstablishedConnections, 64)
-	e.serviceGroupsServerEstablishedConnections.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsServerEstablishedConnections.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsCurrentReusePool(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsCurrentReusePool.Reset()
 
 	val, _ := strconv.ParseFloat(sg.CurrentReusePool, 64)
-	e.serviceGroupsCurrentReusePool.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsCurrentReusePool.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
 
 func (e *Exporter) collectServiceGroupsMaxClients(sg netscaler.ServiceGroupMemberStats, sgName string, servername string) {
 	e.serviceGroupsMaxClients.Reset()
 
 	val, _ := strconv.ParseFloat(sg.MaxClients, 64)
-	e.serviceGroupsMaxClients.WithLabelValues(e.nsInstance, sgName, servername).Set(val)
+	e.serviceGroupsMaxClients.WithLabelValues(e.nsInstance, sgName, servername, e.trafficDomain("servicegroup", sgName)).Set(val)
 }
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// serviceConfig represents the data returned from the /config/service Nitro API endpoint
+type serviceConfig struct {
+	Name        string      `json:"name"`
+	IPAddress   string      `json:"ipaddress"`
+	Port        json.Number `json:"port"`
+	ServerName  string      `json:"servername"`
+	MaxClients  json.Number `json:"maxclient"`
+	ServerState string      `json:"svrstate"`
+}
+
+// virtualServerServiceBindings represents the data returned from the /config/lbvserver_service_binding Nitro API endpoint
+type virtualServerServiceBindings struct {
+	Name        string      `json:"name"`
+	ServiceName string      `json:"servicename"`
+	Weight      json.Number `json:"weight"`
+}
+
+// serviceGroupConfig represents the data returned from the /config/servicegroup Nitro API endpoint
+type serviceGroupConfig struct {
+	ServiceGroupName string      `json:"servicegroupname"`
+	MaxClients       json.Number `json:"maxclient"`
+}
+
+// serviceGroupMemberBindings represents the data returned from the /config/servicegroup_servicegroupmember_binding Nitro API endpoint
+type serviceGroupMemberBindings struct {
+	ServiceGroupName string      `json:"servicegroupname"`
+	IP               string      `json:"ip"`
+	Port             json.Number `json:"port"`
+	ServerName       string      `json:"servername"`
+	Weight           json.Number `json:"weight"`
+	State            string      `json:"state"`
+}
+
+// getServices queries the Nitro API for service config
+func getServices(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "service", querystring)
+}
+
+// getVirtualServerServiceBindings queries the Nitro API for the services bound to each load balancing virtual server
+func getVirtualServerServiceBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "lbvserver_service_binding", querystring)
+}
+
+// getServiceGroups queries the Nitro API for service group config
+func getServiceGroups(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "servicegroup", querystring)
+}
+
+// getServiceGroupMemberBindings queries the Nitro API for the members of each service group
+func getServiceGroupMemberBindings(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "servicegroup_servicegroupmember_binding", querystring)
+}
+
+// member returns the service group member as labelled by the service group stats
+func (m serviceGroupMemberBindings) member() string {
+	if m.IP != "" {
+		return m.IP + `:` + m.Port.String()
+	}
+	return m.ServerName + `:` + m.Port.String()
+}
+
+// joinMonitors returns the sorted, comma separated names of the monitors bound to each service or service group
+func joinMonitors(bound map[string][]string) map[string]string {
+	monitors := make(map[string]string)
+	for name, names := range bound {
+		sort.Strings(names)
+		monitors[name] = strings.Join(names, ",")
+	}
+	return monitors
+}
+
+var serviceInfoLabels = []string{
+	`citrixadc_ip`,
+	`citrixadc_port`,
+	`citrixadc_server`,
+	`citrixadc_weight`,
+	`citrixadc_max_clients`,
+	`citrixadc_monitor`,
+}
+
+// Nitro doesn't return the admin state of services, only the server state, which is reported as it is
+var servicesInfoLabels = append(append(append([]string{}, servicesLabels...), serviceInfoLabels...), `citrixadc_service_state`)
+
+var serviceGroupsMemberInfoLabels = append(append(append([]string{}, serviceGroupsLabels...), serviceInfoLabels...), `citrixadc_admin_state`)
+
+var (
+	servicesInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "service_info",
+			Help:      "Configuration of the service, one series per virtual server the service is bound to.  Always 1.",
+		},
+		servicesInfoLabels,
+	)
+
+	serviceGroupsMemberInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "servicegroup_member_info",
+			Help:      "Configuration of the service group member.  Always 1.",
+		},
+		serviceGroupsMemberInfoLabels,
+	)
+)
+
+func (e *Exporter) collectServicesInfo(ns nitroResponse, bindings nitroResponse, monitorBindings nitroResponse) {
+	e.servicesInfo.Reset()
+
+	bound := make(map[string][]string)
+	for _, b := range monitorBindings.ServiceMonitorBindings {
+		bound[b.Name] = append(bound[b.Name], b.MonitorName)
+	}
+	monitors := joinMonitors(bound)
+
+	vservers := make(map[string][]virtualServerServiceBindings)
+	for _, b := range bindings.VirtualServerServiceBindings {
+		vservers[b.ServiceName] = append(vservers[b.ServiceName], b)
+	}
+
+	for _, service := range ns.Services {
+		td := e.trafficDomain("service", service.Name)
+
+		// The weight is configured when binding the service to a virtual server, so there's a series per binding
+		for _, b := range vservers[service.Name] {
+			e.servicesInfo.WithLabelValues(e.nsInstance, service.Name, b.Name, td,
+				service.IPAddress, service.Port.String(), service.ServerName, b.Weight.String(), service.MaxClients.String(), monitors[service.Name], service.ServerState).Set(1)
+		}
+
+		if len(vservers[service.Name]) == 0 {
+			e.servicesInfo.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), td,
+				service.IPAddress, service.Port.String(), service.ServerName, "", service.MaxClients.String(), monitors[service.Name], service.ServerState).Set(1)
+		}
+	}
+}
+
+func (e *Exporter) collectServiceGroupsMemberInfo(ns nitroResponse, members nitroResponse, monitorBindings nitroResponse) {
+	e.serviceGroupsMemberInfo.Reset()
+
+	bound := make(map[string][]string)
+	for _, b := range monitorBindings.ServiceGroupMonitorBindings {
+		bound[b.ServiceGroupName] = append(bound[b.ServiceGroupName], b.MonitorName)
+	}
+	monitors := joinMonitors(bound)
+
+	maxClients := make(map[string]string)
+	for _, sg := range ns.ServiceGroups {
+		maxClients[sg.ServiceGroupName] = sg.MaxClients.String()
+	}
+
+	for _, m := range members.ServiceGroupMemberBindings {
+		e.serviceGroupsMemberInfo.WithLabelValues(e.nsInstance, m.ServiceGroupName, m.member(), e.trafficDomain("servicegroup", m.ServiceGroupName),
+			m.IP, m.Port.String(), m.ServerName, m.Weight.String(), maxClients[m.ServiceGroupName], monitors[m.ServiceGroupName], m.State).Set(1)
+	}
+}
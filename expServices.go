@@ -14,6 +14,7 @@ var servicesLabels = []string{
 	netscalerInstance,
 	`citrixadc_service_name`,
 	`citrixadc_lb_name`,
+	`citrixadc_traffic_domain`,
 }
 
 var (
@@ -187,7 +188,7 @@ func (e *Exporter) collectServicesThroughput(ns netscaler.NSAPIResponse) {
 		val, _ := strconv.ParseFloat(service.Throughput, 64)
 		// Value is in megabytes. Convert to base unit of bytes
 		throughputInBytes = val * 1024 * 1024
-		e.servicesThroughput.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(throughputInBytes)
+		e.servicesThroughput.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(throughputInBytes)
 	}
 }
 
@@ -198,7 +199,7 @@ func (e *Exporter) collectServicesAvgTTFB(ns netscaler.NSAPIResponse) {
 		var servicesAvgTTFBInSeconds float64
 		val, _ := strconv.ParseFloat(service.AvgTimeToFirstByte, 64)
 		servicesAvgTTFBInSeconds = val * 0.001
-		e.servicesAvgTTFB.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(servicesAvgTTFBInSeconds)
+		e.servicesAvgTTFB.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(servicesAvgTTFBInSeconds)
 	}
 }
 
@@ -217,7 +218,7 @@ func (e *Exporter) collectServicesState(ns netscaler.NSAPIResponse) {
 		default:
 			state = 3.0
 		}
-		e.servicesState.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(state)
+		e.servicesState.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(state)
 	}
 }
 
@@ -226,7 +227,7 @@ func (e *Exporter) collectServicesTotalRequests(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.TotalRequests, 64)
-		e.servicesTotalRequests.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesTotalRequests.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -235,7 +236,7 @@ func (e *Exporter) collectServicesTotalResponses(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.TotalResponses, 64)
-		e.servicesTotalResponses.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesTotalResponses.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -244,7 +245,7 @@ func (e *Exporter) collectServicesTotalRequestBytes(ns netscaler.NSAPIResponse)
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.TotalRequestBytes, 64)
-		e.servicesTotalRequestBytes.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesTotalRequestBytes.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -253,7 +254,7 @@ func (e *Exporter) collectServicesTotalResponseBytes(ns netscaler.NSAPIResponse)
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.TotalResponseBytes, 64)
-		e.servicesTotalResponseBytes.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesTotalResponseBytes.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -262,7 +263,7 @@ func (e *Exporter) collectServicesCurrentClientConns(ns netscaler.NSAPIResponse)
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.CurrentClientConnections, 64)
-		e.servicesCurrentClientConns.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesCurrentClientConns.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -271,7 +272,7 @@ func (e *Exporter) collectServicesSurgeCount(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.SurgeCount, 64)
-		e.servicesSurgeCount.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesSurgeCount.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -280,7 +281,7 @@ func (e *Exporter) collectServicesCurrentServerConns(ns netscaler.NSAPIResponse)
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.CurrentServerConnections, 64)
-		e.servicesCurrentServerConns.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesCurrentServerConns.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -289,7 +290,7 @@ func (e *Exporter) collectServicesServerEstablishedConnections(ns netscaler.NSAP
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.ServerEstablishedConnections, 64)
-		e.servicesServerEstablishedConnections.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesServerEstablishedConnections.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -298,7 +299,7 @@ func (e *Exporter) collectServicesCurrentReusePool(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.CurrentReusePool, 64)
-		e.servicesCurrentReusePool.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesCurrentReusePool.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -307,7 +308,7 @@ func (e *Exporter) collectServicesMaxClients(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.MaxClients, 64)
-		e.servicesMaxClients.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesMaxClients.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -317,7 +318,7 @@ func (e *Exporter) collectServicesCurrentLoad(ns netscaler.NSAPIResponse) {
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.CurrentLoad, 64)
-		e.servicesCurrentLoad.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesCurrentLoad.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 
@@ -326,7 +327,7 @@ func (e *Exporter) collectServicesVirtualServerServiceHits(ns netscaler.NSAPIRes
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.ServiceHits, 64)
-		e.servicesVirtualServerServiceHits.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesVirtualServerServiceHits.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
 */
@@ -336,6 +337,6 @@ func (e *Exporter) collectServicesActiveTransactions(ns netscaler.NSAPIResponse)
 
 	for _, service := range ns.ServiceStats {
 		val, _ := strconv.ParseFloat(service.ActiveTransactions, 64)
-		e.servicesActiveTransactions.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(e.url, service.Name)).Set(val)
+		e.servicesActiveTransactions.WithLabelValues(e.nsInstance, service.Name, currentMapping.getMapping(mappingKey(e.url, e.partition), service.Name), e.trafficDomain("service", service.Name)).Set(val)
 	}
 }
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// persistentSessions represents the data returned from the /config/lbpersistentsessions Nitro API endpoint
+type persistentSessions struct {
+	VServerName string `json:"vservername"`
+	Type        string `json:"typestring"`
+}
+
+// connections represents the data returned from the /config/nsconnectiontable Nitro API endpoint
+type connections struct {
+	EntityName string `json:"entityname"`
+	State      string `json:"state"`
+}
+
+// getPersistentSessions queries the Nitro API for the active persistence sessions of all virtual servers
+func getPersistentSessions(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "lbpersistentsessions", querystring)
+}
+
+// getConnections queries the Nitro API for the connection table
+func getConnections(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nsconnectiontable", querystring)
+}
+
+const persistenceSubsystem = "persistence"
+
+const connectionTableSubsystem = "connection_table"
+
+var persistenceLabels = []string{
+	netscalerInstance,
+	`citrixadc_lb_name`,
+	`citrixadc_persistence_type`,
+}
+
+var connectionTableLabels = []string{
+	netscalerInstance,
+	`citrixadc_vserver_name`,
+	`citrixadc_vserver_type`,
+	`citrixadc_connection_state`,
+}
+
+var (
+	persistenceSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: persistenceSubsystem,
+			Name:      "sessions",
+			Help:      "Number of active persistence sessions on the virtual server, by persistence type",
+		},
+		persistenceLabels,
+	)
+
+	connectionTableConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: connectionTableSubsystem,
+			Name:      "connections",
+			Help:      "Number of client connections in the connection table to the load balancing or content switching virtual server, by connection state",
+		},
+		connectionTableLabels,
+	)
+)
+
+func (e *Exporter) collectPersistenceSessions(ns nitroResponse) {
+	e.persistenceSessions.Reset()
+
+	counts := make(map[[2]string]float64)
+	for _, s := range ns.PersistentSessions {
+		counts[[2]string{s.VServerName, s.Type}]++
+	}
+	for key, count := range counts {
+		e.persistenceSessions.WithLabelValues(e.nsInstance, key[0], key[1]).Set(count)
+	}
+}
+
+func (e *Exporter) collectConnectionTableConnections(ns nitroResponse, lbVServers nitroResponse, csVServers nitroResponse) {
+	e.connectionTableConnections.Reset()
+
+	vserverTypes := make(map[string]string)
+	for _, vs := range lbVServers.VirtualServers {
+		vserverTypes[vs.Name] = "lb"
+	}
+	for _, vs := range csVServers.CSVirtualServers {
+		vserverTypes[vs.Name] = "cs"
+	}
+
+	counts := make(map[[2]string]float64)
+	for _, c := range ns.Connections {
+		// The entity of a server side connection is the service, and connections which aren't associated with a
+		// virtual server, e.g. management traffic, have none, so only client connections to virtual servers are counted
+		if _, ok := vserverTypes[c.EntityName]; !ok {
+			continue
+		}
+		counts[[2]string{c.EntityName, c.State}]++
+	}
+	for key, count := range counts {
+		e.connectionTableConnections.WithLabelValues(e.nsInstance, key[0], vserverTypes[key[0]], key[1]).Set(count)
+	}
+}
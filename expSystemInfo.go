@@ -0,0 +1,128 @@
+package main
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// nsVersion represents the data returned from the /config/nsversion Nitro API endpoint
+type nsVersion struct {
+	Version string `json:"version"`
+}
+
+// nsHardware represents the data returned from the /config/nshardware Nitro API endpoint
+type nsHardware struct {
+	Description string `json:"hwdescription"`
+	SysID       string `json:"sysid"`
+	SerialNo    string `json:"serialno"`
+}
+
+// nsHostname represents the data returned from the /config/nshostname Nitro API endpoint
+type nsHostname struct {
+	Hostname string `json:"hostname"`
+}
+
+// nsTimeFormat is the format Nitro reports times in, such as the system start time
+const nsTimeFormat = "Mon Jan _2 15:04:05 2006"
+
+// versionRegex extracts the release and build from a version string such as "NetScaler NS13.0: Build 47.24.nc, Date: Nov 29 2019, 11:58:51   (64-bit)"
+var versionRegex = regexp.MustCompile(`NS(\d+\.\d+): Build (\d+(?:\.\d+)*)`)
+
+// getNSVersion queries the Nitro API for the firmware version
+func getNSVersion(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nsversion", querystring)
+}
+
+// getNSHardware queries the Nitro API for the hardware details
+func getNSHardware(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nshardware", querystring)
+}
+
+// getNSHostname queries the Nitro API for the hostname
+func getNSHostname(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nshostname", querystring)
+}
+
+// parseVersion splits the version string into the release and build.  The full string is returned as the version if it can't be parsed
+func parseVersion(v string) (string, string) {
+	m := versionRegex.FindStringSubmatch(v)
+	if m == nil {
+		return v, ""
+	}
+	return m[1], m[2]
+}
+
+var buildInfoLabels = []string{
+	netscalerInstance,
+	`version`,
+	`build`,
+	`model`,
+	`serial`,
+	`hostname`,
+	`platform`,
+}
+
+var systemInfoLabels = []string{
+	netscalerInstance,
+}
+
+var (
+	buildInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "build_info",
+			Help:      "Firmware version and hardware details of the NetScaler.  Always 1.",
+		},
+		buildInfoLabels,
+	)
+
+	uptimeSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "uptime_seconds",
+			Help:      "Time since the NetScaler was last started",
+		},
+		systemInfoLabels,
+	)
+
+	bootTimeSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "boot_time_seconds",
+			Help:      "Time the NetScaler was last started, in seconds since the Unix epoch",
+		},
+		systemInfoLabels,
+	)
+)
+
+func (e *Exporter) collectBuildInfo(version nitroResponse, hardware nitroResponse, hostname nitroResponse) {
+	e.buildInfo.Reset()
+
+	release, build := parseVersion(version.NSVersion.Version)
+	e.buildInfo.WithLabelValues(e.nsInstance, release, build, hardware.NSHardware.Description, hardware.NSHardware.SerialNo, hostname.NSHostname.Hostname, hardware.NSHardware.SysID).Set(1)
+}
+
+// The start time is in the NetScaler's local timezone, so it's converted using the nsconfig offset, as for the config timestamps
+func (e *Exporter) collectUptimeSeconds(ns nitroResponse, nsconfig nitroResponse) {
+	e.uptimeSeconds.Reset()
+
+	start, err := nsconfig.NSConfig.timestamp(ns.SystemStats.StartTime)
+	if err != nil {
+		return
+	}
+	e.uptimeSeconds.WithLabelValues(e.nsInstance).Set(float64(time.Now().Unix()) - start)
+}
+
+func (e *Exporter) collectBootTimeSeconds(ns nitroResponse, nsconfig nitroResponse) {
+	e.bootTimeSeconds.Reset()
+
+	start, err := nsconfig.NSConfig.timestamp(ns.SystemStats.StartTime)
+	if err != nil {
+		return
+	}
+	e.bootTimeSeconds.WithLabelValues(e.nsInstance).Set(start)
+}
@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		release string
+		build   string
+	}{
+		{
+			name:    "13.0 VPX",
+			version: "NetScaler NS13.0: Build 47.24.nc, Date: Nov 29 2019, 11:58:51   (64-bit)",
+			release: "13.0",
+			build:   "47.24",
+		},
+		{
+			name:    "12.1 MPX",
+			version: "NetScaler NS12.1: Build 55.18.nc, Date: Oct 29 2019, 07:14:44   (64-bit)",
+			release: "12.1",
+			build:   "55.18",
+		},
+		{
+			name:    "11.1 FIPS",
+			version: "NetScaler NS11.1: Build 63.9.e.nc, Date: Nov 14 2019, 12:47:33   (64-bit)",
+			release: "11.1",
+			build:   "63.9",
+		},
+		{
+			name:    "unparseable",
+			version: "Citrix ADC unknown release",
+			release: "Citrix ADC unknown release",
+			build:   "",
+		},
+		{
+			name:    "empty",
+			version: "",
+			release: "",
+			build:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			release, build := parseVersion(tc.version)
+			if release != tc.release || build != tc.build {
+				t.Errorf("expected %q, %q, got %q, %q", tc.release, tc.build, release, build)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// systemMemoryStats represents the data returned from the /stat/systemmemory Nitro API endpoint.
+// Depending on the firmware Nitro reports the counters as either numbers or strings, hence json.Number.
+type systemMemoryStats struct {
+	MemTotalAllocPcnt    json.Number `json:"memtotallocpcnt"`
+	MemTotalAllocMB      json.Number `json:"memtotallocmb"`
+	MemTotalMB           json.Number `json:"memtotinmb"`
+	MemTotalFreeMB       json.Number `json:"memtotfree"`
+	MemAllocFailed       json.Number `json:"memerrallocfailed"`
+	SharedMemAllocPcnt   json.Number `json:"shmemallocpcnt"`
+	SharedMemAllocMB     json.Number `json:"shmemallocinmb"`
+	SharedMemTotalMB     json.Number `json:"shmemtotinmb"`
+	SharedMemAllocFailed json.Number `json:"shmemerrallocfailed"`
+}
+
+// memoryPool is a single pool within the system memory breakdown
+type memoryPool struct {
+	name        string
+	allocPcnt   json.Number
+	allocMB     json.Number
+	totalMB     json.Number
+	freeMB      json.Number
+	allocFailed json.Number
+}
+
+// getSystemMemoryStats queries the Nitro API for system memory stats
+func getSystemMemoryStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "systemmemory", querystring)
+}
+
+// pools breaks the system memory stats down into the memory pools.
+// Nitro only reports totals for the system and shared memory pools, not the individual allocation pools within them
+func (s systemMemoryStats) pools() []memoryPool {
+	var sharedFreeMB json.Number
+	sharedTotal, err1 := s.SharedMemTotalMB.Float64()
+	sharedAlloc, err2 := s.SharedMemAllocMB.Float64()
+	if err1 == nil && err2 == nil {
+		sharedFreeMB = json.Number(strconv.FormatFloat(sharedTotal-sharedAlloc, 'f', -1, 64))
+	}
+
+	return []memoryPool{
+		{
+			name:        "system",
+			allocPcnt:   s.MemTotalAllocPcnt,
+			allocMB:     s.MemTotalAllocMB,
+			totalMB:     s.MemTotalMB,
+			freeMB:      s.MemTotalFreeMB,
+			allocFailed: s.MemAllocFailed,
+		},
+		{
+			name:        "shared",
+			allocPcnt:   s.SharedMemAllocPcnt,
+			allocMB:     s.SharedMemAllocMB,
+			totalMB:     s.SharedMemTotalMB,
+			freeMB:      sharedFreeMB,
+			allocFailed: s.SharedMemAllocFailed,
+		},
+	}
+}
+
+const systemMemorySubsystem = "memory"
+
+var systemMemoryLabels = []string{
+	netscalerInstance,
+	`citrixadc_memory_pool`,
+}
+
+var (
+	systemMemoryAllocatedPct = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: systemMemorySubsystem,
+			Name:      "allocated_pct",
+			Help:      "Memory allocated from the pool, as a percentage of the pool size",
+		},
+		systemMemoryLabels,
+	)
+
+	systemMemoryAllocatedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: systemMemorySubsystem,
+			Name:      "allocated_bytes",
+			Help:      "Memory currently allocated from the pool",
+		},
+		systemMemoryLabels,
+	)
+
+	systemMemorySizeBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: systemMemorySubsystem,
+			Name:      "size_bytes",
+			Help:      "Total memory available to the pool",
+		},
+		systemMemoryLabels,
+	)
+
+	systemMemoryFreeBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: systemMemorySubsystem,
+			Name:      "free_bytes",
+			Help:      "Memory in the pool which is not currently allocated",
+		},
+		systemMemoryLabels,
+	)
+
+	systemMemoryAllocationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: systemMemorySubsystem,
+			Name:      "allocation_failures_total",
+			Help:      "Number of times memory could not be allocated from the pool",
+		},
+		systemMemoryLabels,
+	)
+)
+
+func (e *Exporter) collectSystemMemoryAllocatedPct(ns nitroResponse) {
+	e.systemMemoryAllocatedPct.Reset()
+
+	for _, pool := range ns.SystemMemoryStats.pools() {
+		val, _ := pool.allocPcnt.Float64()
+		e.systemMemoryAllocatedPct.WithLabelValues(e.nsInstance, pool.name).Set(val)
+	}
+}
+
+func (e *Exporter) collectSystemMemoryAllocatedBytes(ns nitroResponse) {
+	e.systemMemoryAllocatedBytes.Reset()
+
+	for _, pool := range ns.SystemMemoryStats.pools() {
+		val, _ := pool.allocMB.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.systemMemoryAllocatedBytes.WithLabelValues(e.nsInstance, pool.name).Set(val * 1024 * 1024)
+	}
+}
+
+func (e *Exporter) collectSystemMemorySizeBytes(ns nitroResponse) {
+	e.systemMemorySizeBytes.Reset()
+
+	for _, pool := range ns.SystemMemoryStats.pools() {
+		val, _ := pool.totalMB.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.systemMemorySizeBytes.WithLabelValues(e.nsInstance, pool.name).Set(val * 1024 * 1024)
+	}
+}
+
+func (e *Exporter) collectSystemMemoryFreeBytes(ns nitroResponse) {
+	e.systemMemoryFreeBytes.Reset()
+
+	for _, pool := range ns.SystemMemoryStats.pools() {
+		val, _ := pool.freeMB.Float64()
+		// Value is in megabytes. Convert to base unit of bytes
+		e.systemMemoryFreeBytes.WithLabelValues(e.nsInstance, pool.name).Set(val * 1024 * 1024)
+	}
+}
+
+func (e *Exporter) collectSystemMemoryAllocationFailures(ns nitroResponse) {
+	e.systemMemoryAllocationFailures.Reset()
+
+	for _, pool := range ns.SystemMemoryStats.pools() {
+		val, _ := pool.allocFailed.Float64()
+		e.systemMemoryAllocationFailures.WithLabelValues(e.nsInstance, pool.name).Set(val)
+	}
+}
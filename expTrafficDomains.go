@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// defaultTrafficDomain is the traffic domain entities belong to when none has been configured
+const defaultTrafficDomain = "0"
+
+// trafficDomains represents the data returned from the /config/nstrafficdomain Nitro API endpoint
+type trafficDomains struct {
+	TD        json.Number `json:"td"`
+	AliasName string      `json:"aliasname"`
+	State     string      `json:"state"`
+}
+
+// trafficDomainEntity is a single entity returned when querying a config endpoint for its traffic domain
+type trafficDomainEntity struct {
+	Name             string      `json:"name"`
+	ServiceGroupName string      `json:"servicegroupname"`
+	TD               json.Number `json:"td"`
+}
+
+// trafficDomainTypes lists the config types whose traffic domain is added to their metrics
+var trafficDomainTypes = []string{"lbvserver", "csvserver", "service", "servicegroup"}
+
+// getTrafficDomains queries the Nitro API for the configured traffic domains
+func getTrafficDomains(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "nstrafficdomain", querystring)
+}
+
+// getTrafficDomainMembers queries the Nitro API for the traffic domain of each entity of the given config type.
+// The config endpoints share their keys with the stats endpoints, so the response is unmarshalled separately.
+func getTrafficDomainMembers(c *netscaler.NitroClient, configType string) (map[string]string, error) {
+	nameAttr := "name"
+	if configType == "servicegroup" {
+		nameAttr = "servicegroupname"
+	}
+
+	cfg, err := c.GetConfig(configType, "attrs="+nameAttr+",td")
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]json.RawMessage
+	err = json.Unmarshal(cfg, &response)
+	if err != nil {
+		return nil, errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	var entities []trafficDomainEntity
+	if raw, ok := response[configType]; ok {
+		err = json.Unmarshal(raw, &entities)
+		if err != nil {
+			return nil, errors.New("error unmarshalling response body: " + err.Error())
+		}
+	}
+
+	members := make(map[string]string)
+	for _, entity := range entities {
+		name := entity.Name
+		if configType == "servicegroup" {
+			name = entity.ServiceGroupName
+		}
+		members[name] = trafficDomainLabel(entity.TD)
+	}
+	return members, nil
+}
+
+// trafficDomainLabel returns the label value for the traffic domain, falling back to the default traffic domain
+func trafficDomainLabel(td json.Number) string {
+	if td == "" {
+		return defaultTrafficDomain
+	}
+	return td.String()
+}
+
+// trafficDomain returns the traffic domain of the named entity of the given config type.
+// Entity names are unique within a partition regardless of traffic domain, as the NetScaler rejects adding an
+// entity with a name already in use in another traffic domain, so the name alone identifies the entity
+func (e *Exporter) trafficDomain(configType string, name string) string {
+	if td, ok := e.trafficDomainMembers[configType][name]; ok {
+		return td
+	}
+	return defaultTrafficDomain
+}
+
+func (s servers) trafficDomain() string {
+	return trafficDomainLabel(s.TD)
+}
+
+const trafficDomainsSubsystem = "traffic_domain"
+
+var trafficDomainsLabels = []string{
+	netscalerInstance,
+	`citrixadc_traffic_domain`,
+}
+
+var trafficDomainsStateLabels = []string{
+	netscalerInstance,
+	`citrixadc_traffic_domain`,
+	`citrixadc_traffic_domain_alias`,
+}
+
+var trafficDomainsEntityLabels = []string{
+	netscalerInstance,
+	`citrixadc_traffic_domain`,
+	`citrixadc_traffic_domain_entity`,
+}
+
+var (
+	trafficDomainsState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: trafficDomainsSubsystem,
+			Name:      "state",
+			Help:      "Current state of the traffic domain. 0 = DISABLED, 1 = ENABLED, 3 = UNKNOWN",
+		},
+		trafficDomainsStateLabels,
+	)
+
+	trafficDomainsEntities = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: trafficDomainsSubsystem,
+			Name:      "entities",
+			Help:      "Number of entities of each type configured in the traffic domain",
+		},
+		trafficDomainsEntityLabels,
+	)
+
+	trafficDomainsCurrentClientConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: trafficDomainsSubsystem,
+			Name:      "current_client_connections",
+			Help:      "Number of current client connections to the load balancing virtual servers in the traffic domain",
+		},
+		trafficDomainsLabels,
+	)
+)
+
+func (e *Exporter) collectTrafficDomainsState(ns nitroResponse) {
+	e.trafficDomainsState.Reset()
+
+	for _, td := range ns.TrafficDomains {
+		var state float64
+		switch td.State {
+		case `DISABLED`:
+			state = 0.0
+		case `ENABLED`:
+			state = 1.0
+		default:
+			state = 3.0
+		}
+		e.trafficDomainsState.WithLabelValues(e.nsInstance, trafficDomainLabel(td.TD), td.AliasName).Set(state)
+	}
+}
+
+func (e *Exporter) collectTrafficDomainsEntities(servers nitroResponse) {
+	e.trafficDomainsEntities.Reset()
+
+	for _, configType := range trafficDomainTypes {
+		counts := make(map[string]float64)
+		for _, td := range e.trafficDomainMembers[configType] {
+			counts[td]++
+		}
+		for td, count := range counts {
+			e.trafficDomainsEntities.WithLabelValues(e.nsInstance, td, configType).Set(count)
+		}
+	}
+
+	counts := make(map[string]float64)
+	for _, s := range servers.Servers {
+		counts[s.trafficDomain()]++
+	}
+	for td, count := range counts {
+		e.trafficDomainsEntities.WithLabelValues(e.nsInstance, td, "server").Set(count)
+	}
+}
+
+// virtualServerTotals sums a virtual server stat per traffic domain.  Only gauges are summed, as a sum of counters
+// would drop whenever a virtual server is removed or moved to another traffic domain
+func (e *Exporter) virtualServerTotals(ns nitroResponse, stat func(vs virtualServerStats) string) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, vs := range ns.VirtualServerStats {
+		val, _ := strconv.ParseFloat(stat(vs), 64)
+		totals[e.trafficDomain("lbvserver", vs.Name)] += val
+	}
+	return totals
+}
+
+func (e *Exporter) collectTrafficDomainsCurrentClientConnections(ns nitroResponse) {
+	e.trafficDomainsCurrentClientConnections.Reset()
+
+	for td, val := range e.virtualServerTotals(ns, func(vs virtualServerStats) string { return vs.CurrentClientConnections }) {
+		e.trafficDomainsCurrentClientConnections.WithLabelValues(e.nsInstance, td).Set(val)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// vlanStats represents the data returned from the /stat/vlan Nitro API endpoint
+type vlanStats struct {
+	ID                    string      `json:"id"`
+	TotalRxPackets        json.Number `json:"vlantotrxpkts"`
+	TotalRxBytes          json.Number `json:"vlantotrxbytes"`
+	TotalTxPackets        json.Number `json:"vlantottxpkts"`
+	TotalTxBytes          json.Number `json:"vlantottxbytes"`
+	TotalDroppedPackets   json.Number `json:"vlantotdroppedpkts"`
+	TotalBroadcastPackets json.Number `json:"vlantotbroadcastpkts"`
+}
+
+// getVLANStats queries the Nitro API for VLAN stats
+func getVLANStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "vlan", querystring)
+}
+
+const vlansSubsystem = "vlan"
+
+var vlansLabels = []string{
+	netscalerInstance,
+	`vlan`,
+}
+
+var (
+	vlansRxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "received_packets_total",
+			Help:      "Number of packets received on the VLAN",
+		},
+		vlansLabels,
+	)
+
+	vlansRxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "received_bytes_total",
+			Help:      "Number of bytes received on the VLAN",
+		},
+		vlansLabels,
+	)
+
+	vlansTxPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "transmitted_packets_total",
+			Help:      "Number of packets transmitted on the VLAN",
+		},
+		vlansLabels,
+	)
+
+	vlansTxBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "transmitted_bytes_total",
+			Help:      "Number of bytes transmitted on the VLAN",
+		},
+		vlansLabels,
+	)
+
+	vlansDroppedPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "dropped_packets_total",
+			Help:      "Number of packets dropped on the VLAN",
+		},
+		vlansLabels,
+	)
+
+	vlansBroadcastPackets = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vlansSubsystem,
+			Name:      "broadcast_packets_total",
+			Help:      "Number of broadcast packets sent and received on the VLAN",
+		},
+		vlansLabels,
+	)
+)
+
+func (e *Exporter) collectVLANsRxPackets(ns nitroResponse) {
+	e.vlansRxPackets.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalRxPackets.Float64()
+		e.vlansRxPackets.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
+
+func (e *Exporter) collectVLANsRxBytes(ns nitroResponse) {
+	e.vlansRxBytes.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalRxBytes.Float64()
+		e.vlansRxBytes.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
+
+func (e *Exporter) collectVLANsTxPackets(ns nitroResponse) {
+	e.vlansTxPackets.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalTxPackets.Float64()
+		e.vlansTxPackets.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
+
+func (e *Exporter) collectVLANsTxBytes(ns nitroResponse) {
+	e.vlansTxBytes.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalTxBytes.Float64()
+		e.vlansTxBytes.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
+
+func (e *Exporter) collectVLANsDroppedPackets(ns nitroResponse) {
+	e.vlansDroppedPackets.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalDroppedPackets.Float64()
+		e.vlansDroppedPackets.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
+
+func (e *Exporter) collectVLANsBroadcastPackets(ns nitroResponse) {
+	e.vlansBroadcastPackets.Reset()
+
+	for _, vlan := range ns.VLANStats {
+		val, _ := vlan.TotalBroadcastPackets.Float64()
+		e.vlansBroadcastPackets.WithLabelValues(e.nsInstance, vlan.ID).Set(val)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// vpnStats represents the data returned from the /stat/vpn Nitro API endpoint
+type vpnStats struct {
+	IndexHTMLHit      json.Number `json:"indexhtmlhit"`
+	ICALicenseFailure json.Number `json:"icalicensefailure"`
+	STARequestSent    json.Number `json:"starequestsent"`
+	STAConnSuccess    json.Number `json:"staconnsuccess"`
+	STAConnFailure    json.Number `json:"staconnfailure"`
+}
+
+// getVPNStats queries the Nitro API for NetScaler Gateway stats
+func getVPNStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "vpn", querystring)
+}
+
+const vpnSubsystem = "vpn"
+
+var vpnLabels = []string{
+	netscalerInstance,
+}
+
+var (
+	vpnLoginPageHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "login_page_hits_total",
+			Help:      "Total number of requests for the NetScaler Gateway login page",
+		},
+		vpnLabels,
+	)
+
+	vpnICALicenseFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "ica_license_failures_total",
+			Help:      "Total number of ICA connections refused because no license was available",
+		},
+		vpnLabels,
+	)
+
+	vpnSTARequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "sta_requests_total",
+			Help:      "Total number of requests sent to the Secure Ticket Authority",
+		},
+		vpnLabels,
+	)
+
+	vpnSTAConnectionSuccess = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "sta_connection_success_total",
+			Help:      "Total number of successful connections to the Secure Ticket Authority",
+		},
+		vpnLabels,
+	)
+
+	vpnSTAConnectionFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: vpnSubsystem,
+			Name:      "sta_connection_failures_total",
+			Help:      "Total number of failed connections to the Secure Ticket Authority",
+		},
+		vpnLabels,
+	)
+)
+
+func (e *Exporter) collectVPNLoginPageHits(ns nitroResponse) {
+	e.vpnLoginPageHits.Reset()
+
+	val, _ := ns.VPNStats.IndexHTMLHit.Float64()
+	e.vpnLoginPageHits.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectVPNICALicenseFailures(ns nitroResponse) {
+	e.vpnICALicenseFailures.Reset()
+
+	val, _ := ns.VPNStats.ICALicenseFailure.Float64()
+	e.vpnICALicenseFailures.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectVPNSTARequests(ns nitroResponse) {
+	e.vpnSTARequests.Reset()
+
+	val, _ := ns.VPNStats.STARequestSent.Float64()
+	e.vpnSTARequests.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectVPNSTAConnectionSuccess(ns nitroResponse) {
+	e.vpnSTAConnectionSuccess.Reset()
+
+	val, _ := ns.VPNStats.STAConnSuccess.Float64()
+	e.vpnSTAConnectionSuccess.WithLabelValues(e.nsInstance).Set(val)
+}
+
+func (e *Exporter) collectVPNSTAConnectionFailures(ns nitroResponse) {
+	e.vpnSTAConnectionFailures.Reset()
+
+	val, _ := ns.VPNStats.STAConnFailure.Float64()
+	e.vpnSTAConnectionFailures.WithLabelValues(e.nsInstance).Set(val)
+}
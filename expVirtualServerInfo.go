@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/jbvmio/netscaler"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// virtualServerConfig represents the data returned from the /config/lbvserver, /config/csvserver and /config/gslbvserver Nitro API endpoints.
+// Not every field is returned for every type of virtual server, e.g. GSLB virtual servers have no IP address or port.
+type virtualServerConfig struct {
+	Name            string      `json:"name"`
+	IPAddress       string      `json:"ipv46"`
+	Port            json.Number `json:"port"`
+	ServiceType     string      `json:"servicetype"`
+	LBMethod        string      `json:"lbmethod"`
+	PersistenceType string      `json:"persistencetype"`
+	BackupVServer   string      `json:"backupvserver"`
+	Comment         string      `json:"comment"`
+}
+
+// getVirtualServers queries the Nitro API for load balancing virtual server configuration.
+// Nitro returns the configuration under the same "lbvserver" key as the stats, so it can't be unmarshalled into nitroResponse directly
+func getVirtualServers(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	cfg, err := c.GetConfig("lbvserver", querystring)
+	if err != nil {
+		return nitroResponse{}, err
+	}
+
+	var response struct {
+		VirtualServers []virtualServerConfig `json:"lbvserver"`
+	}
+
+	err = json.Unmarshal(cfg, &response)
+	if err != nil {
+		return nitroResponse{}, errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	return nitroResponse{VirtualServers: response.VirtualServers}, nil
+}
+
+// getCSVirtualServers queries the Nitro API for content switching virtual server configuration
+func getCSVirtualServers(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "csvserver", querystring)
+}
+
+// getGSLBVirtualServers queries the Nitro API for GSLB virtual server configuration
+func getGSLBVirtualServers(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getConfig(c, "gslbvserver", querystring)
+}
+
+var virtualServerInfoLabels = []string{
+	`citrixadc_ip`,
+	`citrixadc_port`,
+	`citrixadc_service_type`,
+	`citrixadc_lb_method`,
+	`citrixadc_persistence_type`,
+	`citrixadc_backup_vserver`,
+	`citrixadc_comment`,
+}
+
+// values returns the values for virtualServerInfoLabels
+func (vs virtualServerConfig) values() []string {
+	return []string{vs.IPAddress, vs.Port.String(), vs.ServiceType, vs.LBMethod, vs.PersistenceType, vs.BackupVServer, vs.Comment}
+}
+
+var (
+	virtualServersInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "lbvserver_info",
+			Help:      "Configuration of the load balancing virtual server.  Always 1.",
+		},
+		append(append([]string{}, virtualServersLabels...), virtualServerInfoLabels...),
+	)
+
+	csVirtualServersInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "csvserver_info",
+			Help:      "Configuration of the content switching virtual server.  Always 1.",
+		},
+		append(append([]string{}, csVirtualServersLabels...), virtualServerInfoLabels...),
+	)
+
+	gslbVirtualServersInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gslbvserver_info",
+			Help:      "Configuration of the GSLB virtual server.  Always 1.",
+		},
+		append(append([]string{}, gslbVirtualServersLabels...), virtualServerInfoLabels...),
+	)
+)
+
+func (e *Exporter) collectVirtualServerInfo(ns nitroResponse) {
+	e.virtualServersInfo.Reset()
+
+	for _, vs := range ns.VirtualServers {
+		labels := append([]string{e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)}, vs.values()...)
+		e.virtualServersInfo.WithLabelValues(labels...).Set(1)
+	}
+}
+
+func (e *Exporter) collectCSVirtualServerInfo(ns nitroResponse) {
+	e.csVirtualServersInfo.Reset()
+
+	for _, vs := range ns.CSVirtualServers {
+		labels := append([]string{e.nsInstance, vs.Name, e.trafficDomain("csvserver", vs.Name)}, vs.values()...)
+		e.csVirtualServersInfo.WithLabelValues(labels...).Set(1)
+	}
+}
+
+func (e *Exporter) collectGSLBVirtualServerInfo(ns nitroResponse) {
+	e.gslbVirtualServersInfo.Reset()
+
+	for _, vs := range ns.GSLBVirtualServers {
+		labels := append([]string{e.nsInstance, vs.Name}, vs.values()...)
+		e.gslbVirtualServersInfo.WithLabelValues(labels...).Set(1)
+	}
+}
@@ -8,11 +8,33 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// virtualServerStats extends netscaler.VirtualServerStats with the additional data returned from the /stat/lbvserver Nitro API endpoint
+type virtualServerStats struct {
+	netscaler.VirtualServerStats
+	SurgeCount                    string `json:"surgecount"`
+	TotalSpillovers               string `json:"totspillovers"`
+	TotalVServerDownBackupHits    string `json:"totvserverdownbackuphits"`
+	AvgClientTTLB                 string `json:"avgcltttlb"`
+	InvalidRequestResponse        string `json:"invalidrequestresponse"`
+	InvalidRequestResponseDropped string `json:"invalidrequestresponsedropped"`
+	TotalPacketsReceived          string `json:"totalpktsrecvd"`
+	TotalPacketsSent              string `json:"totalpktssent"`
+	EstablishedConnections        string `json:"establishedconn"`
+	DeferredRequests              string `json:"deferredreq"`
+	TotalServerBusyErrors         string `json:"totalsvrbusyerr"`
+}
+
+// getVirtualServerStats queries the Nitro API for virtual server stats
+func getVirtualServerStats(c *netscaler.NitroClient, querystring string) (nitroResponse, error) {
+	return getStats(c, "lbvserver", querystring)
+}
+
 const virtualServersSubsystem = "lb_vserver"
 
 var virtualServersLabels = []string{
 	netscalerInstance,
 	`citrixadc_lb_name`,
+	`citrixadc_traffic_domain`,
 }
 
 var (
@@ -134,108 +156,218 @@ var (
 		},
 		virtualServersLabels,
 	)
+
+	virtualServersSurgeCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "surge_queue",
+			Help:      "Number of requests in the surge queue",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersTotalSpillovers = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "spillovers_total",
+			Help:      "Number of times vserver experienced spill over.",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersTotalVServerDownBackupHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "vserver_down_backup_hits_total",
+			Help:      "Number of times traffic was diverted to backup vserver since primary vserver was DOWN.",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersAvgClientTTLB = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "average_client_time_to_last_byte_seconds",
+			Help:      "Average TTLB between the client and the server. TTLB is the time interval between sending the request packet to a service and receiving the ACK for the last response packet from the client.",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersNumberInvalidRequestResponse = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "invalid_request_response_total",
+			Help:      "Number invalid requests/responses on this vserver",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersNumberInvalidRequestResponseDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "invalid_request_response_dropped_total",
+			Help:      "Number invalid requests/responses dropped on this vserver",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersTotalPacketsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "packets_received_total",
+			Help:      "Total number of packets received",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersTotalPacketsSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "packets_sent_total",
+			Help:      "Total number of packets sent.",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersEstablishedConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "established_connections",
+			Help:      "Number of client connections in ESTABLISHED state.",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersDeferredRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "deferred_requests_total",
+			Help:      "Number of deferred request on this vserver",
+		},
+		virtualServersLabels,
+	)
+
+	virtualServersTotalServerBusyErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: virtualServersSubsystem,
+			Name:      "server_busy_errors_total",
+			Help:      "Number of times the server was busy and the request could not be served",
+		},
+		virtualServersLabels,
+	)
 )
 
-func (e *Exporter) collectVirtualServerWaitingRequests(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerWaitingRequests(ns nitroResponse) {
 	e.virtualServersWaitingRequests.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		waitingRequests, _ := strconv.ParseFloat(vs.WaitingRequests, 64)
-		e.virtualServersWaitingRequests.WithLabelValues(e.nsInstance, vs.Name).Set(waitingRequests)
+		e.virtualServersWaitingRequests.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(waitingRequests)
 	}
 }
 
-func (e *Exporter) collectVirtualServerHealth(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerHealth(ns nitroResponse) {
 	e.virtualServersHealth.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		health, _ := strconv.ParseFloat(vs.Health, 64)
-		e.virtualServersHealth.WithLabelValues(e.nsInstance, vs.Name).Set(health)
+		e.virtualServersHealth.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(health)
 	}
 }
 
-func (e *Exporter) collectVirtualServerInactiveServices(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerInactiveServices(ns nitroResponse) {
 	e.virtualServersInactiveServices.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		inactiveServices, _ := strconv.ParseFloat(vs.InactiveServices, 64)
-		e.virtualServersInactiveServices.WithLabelValues(e.nsInstance, vs.Name).Set(inactiveServices)
+		e.virtualServersInactiveServices.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(inactiveServices)
 	}
 }
 
-func (e *Exporter) collectVirtualServerActiveServices(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerActiveServices(ns nitroResponse) {
 	e.virtualServersActiveServices.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		activeServices, _ := strconv.ParseFloat(vs.ActiveServices, 64)
-		e.virtualServersActiveServices.WithLabelValues(e.nsInstance, vs.Name).Set(activeServices)
+		e.virtualServersActiveServices.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(activeServices)
 	}
 }
 
-func (e *Exporter) collectVirtualServerTotalHits(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerTotalHits(ns nitroResponse) {
 	e.virtualServersTotalHits.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		totalHits, _ := strconv.ParseFloat(vs.TotalHits, 64)
-		e.virtualServersTotalHits.WithLabelValues(e.nsInstance, vs.Name).Set(totalHits)
+		e.virtualServersTotalHits.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalHits)
 	}
 }
 
-func (e *Exporter) collectVirtualServerTotalRequests(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerTotalRequests(ns nitroResponse) {
 	e.virtualServersTotalRequests.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		totalRequests, _ := strconv.ParseFloat(vs.TotalRequests, 64)
-		e.virtualServersTotalRequests.WithLabelValues(e.nsInstance, vs.Name).Set(totalRequests)
+		e.virtualServersTotalRequests.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalRequests)
 	}
 }
 
-func (e *Exporter) collectVirtualServerTotalResponses(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerTotalResponses(ns nitroResponse) {
 	e.virtualServersTotalResponses.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		totalResponses, _ := strconv.ParseFloat(vs.TotalResponses, 64)
-		e.virtualServersTotalResponses.WithLabelValues(e.nsInstance, vs.Name).Set(totalResponses)
+		e.virtualServersTotalResponses.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalResponses)
 	}
 }
 
-func (e *Exporter) collectVirtualServerTotalRequestBytes(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerTotalRequestBytes(ns nitroResponse) {
 	e.virtualServersTotalRequestBytes.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		totalRequestBytes, _ := strconv.ParseFloat(vs.TotalRequestBytes, 64)
-		e.virtualServersTotalRequestBytes.WithLabelValues(e.nsInstance, vs.Name).Set(totalRequestBytes)
+		e.virtualServersTotalRequestBytes.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalRequestBytes)
 	}
 }
 
-func (e *Exporter) collectVirtualServerTotalResponseBytes(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerTotalResponseBytes(ns nitroResponse) {
 	e.virtualServersTotalResponseBytes.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		totalResponseBytes, _ := strconv.ParseFloat(vs.TotalResponseBytes, 64)
-		e.virtualServersTotalResponseBytes.WithLabelValues(e.nsInstance, vs.Name).Set(totalResponseBytes)
+		e.virtualServersTotalResponseBytes.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalResponseBytes)
 	}
 }
 
-func (e *Exporter) collectVirtualServerCurrentClientConnections(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerCurrentClientConnections(ns nitroResponse) {
 	e.virtualServersCurrentClientConnections.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		currentClientConnections, _ := strconv.ParseFloat(vs.CurrentClientConnections, 64)
-		e.virtualServersCurrentClientConnections.WithLabelValues(e.nsInstance, vs.Name).Set(currentClientConnections)
+		e.virtualServersCurrentClientConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(currentClientConnections)
 	}
 }
 
-func (e *Exporter) collectVirtualServerCurrentServerConnections(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerCurrentServerConnections(ns nitroResponse) {
 	e.virtualServersCurrentServerConnections.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
 		currentServerConnections, _ := strconv.ParseFloat(vs.CurrentServerConnections, 64)
-		e.virtualServersCurrentServerConnections.WithLabelValues(e.nsInstance, vs.Name).Set(currentServerConnections)
+		e.virtualServersCurrentServerConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(currentServerConnections)
 	}
 }
 
-func (e *Exporter) collectVirtualServerState(ns netscaler.NSAPIResponse) {
+func (e *Exporter) collectVirtualServerState(ns nitroResponse) {
 	e.virtualServersState.Reset()
 
 	for _, vs := range ns.VirtualServerStats {
@@ -251,6 +383,107 @@ func (e *Exporter) collectVirtualServerState(ns netscaler.NSAPIResponse) {
 			state = 3.0
 		}
 
-		e.virtualServersState.WithLabelValues(e.nsInstance, vs.Name).Set(state)
+		e.virtualServersState.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(state)
+	}
+}
+
+func (e *Exporter) collectVirtualServerSurgeCount(ns nitroResponse) {
+	e.virtualServersSurgeCount.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		surgeCount, _ := strconv.ParseFloat(vs.SurgeCount, 64)
+		e.virtualServersSurgeCount.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(surgeCount)
+	}
+}
+
+func (e *Exporter) collectVirtualServerTotalSpillovers(ns nitroResponse) {
+	e.virtualServersTotalSpillovers.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		totalSpillovers, _ := strconv.ParseFloat(vs.TotalSpillovers, 64)
+		e.virtualServersTotalSpillovers.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalSpillovers)
+	}
+}
+
+func (e *Exporter) collectVirtualServerTotalVServerDownBackupHits(ns nitroResponse) {
+	e.virtualServersTotalVServerDownBackupHits.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		totalVServerDownBackupHits, _ := strconv.ParseFloat(vs.TotalVServerDownBackupHits, 64)
+		e.virtualServersTotalVServerDownBackupHits.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalVServerDownBackupHits)
+	}
+}
+
+func (e *Exporter) collectVirtualServerAvgClientTTLB(ns nitroResponse) {
+	e.virtualServersAvgClientTTLB.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		var avgClientTTLBInSeconds float64
+		val, _ := strconv.ParseFloat(vs.AvgClientTTLB, 64)
+		avgClientTTLBInSeconds = val * 0.001
+		e.virtualServersAvgClientTTLB.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(avgClientTTLBInSeconds)
+	}
+}
+
+func (e *Exporter) collectVirtualServerNumberInvalidRequestResponse(ns nitroResponse) {
+	e.virtualServersNumberInvalidRequestResponse.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		numberInvalidRequestResponse, _ := strconv.ParseFloat(vs.InvalidRequestResponse, 64)
+		e.virtualServersNumberInvalidRequestResponse.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(numberInvalidRequestResponse)
+	}
+}
+
+func (e *Exporter) collectVirtualServerNumberInvalidRequestResponseDropped(ns nitroResponse) {
+	e.virtualServersNumberInvalidRequestResponseDropped.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		numberInvalidRequestResponseDropped, _ := strconv.ParseFloat(vs.InvalidRequestResponseDropped, 64)
+		e.virtualServersNumberInvalidRequestResponseDropped.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(numberInvalidRequestResponseDropped)
+	}
+}
+
+func (e *Exporter) collectVirtualServerTotalPacketsReceived(ns nitroResponse) {
+	e.virtualServersTotalPacketsReceived.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		totalPacketsReceived, _ := strconv.ParseFloat(vs.TotalPacketsReceived, 64)
+		e.virtualServersTotalPacketsReceived.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalPacketsReceived)
+	}
+}
+
+func (e *Exporter) collectVirtualServerTotalPacketsSent(ns nitroResponse) {
+	e.virtualServersTotalPacketsSent.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		totalPacketsSent, _ := strconv.ParseFloat(vs.TotalPacketsSent, 64)
+		e.virtualServersTotalPacketsSent.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalPacketsSent)
+	}
+}
+
+func (e *Exporter) collectVirtualServerEstablishedConnections(ns nitroResponse) {
+	e.virtualServersEstablishedConnections.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		establishedConnections, _ := strconv.ParseFloat(vs.EstablishedConnections, 64)
+		e.virtualServersEstablishedConnections.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(establishedConnections)
+	}
+}
+
+func (e *Exporter) collectVirtualServerDeferredRequests(ns nitroResponse) {
+	e.virtualServersDeferredRequests.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		deferredRequests, _ := strconv.ParseFloat(vs.DeferredRequests, 64)
+		e.virtualServersDeferredRequests.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(deferredRequests)
+	}
+}
+
+func (e *Exporter) collectVirtualServerTotalServerBusyErrors(ns nitroResponse) {
+	e.virtualServersTotalServerBusyErrors.Reset()
+
+	for _, vs := range ns.VirtualServerStats {
+		totalServerBusyErrors, _ := strconv.ParseFloat(vs.TotalServerBusyErrors, 64)
+		e.virtualServersTotalServerBusyErrors.WithLabelValues(e.nsInstance, vs.Name, e.trafficDomain("lbvserver", vs.Name)).Set(totalServerBusyErrors)
 	}
 }
@@ -15,52 +15,77 @@ const (
 
 // Exporter represents the metrics exported to Prometheus
 type Exporter struct {
-	modelID                                *prometheus.Desc
-	mgmtCPUUsage                           *prometheus.Desc
-	memUsage                               *prometheus.Desc
-	pktCPUUsage                            *prometheus.Desc
-	flashPartitionUsage                    *prometheus.Desc
-	varPartitionUsage                      *prometheus.Desc
-	totRxBytes                             *prometheus.Desc
-	totTxBytes                             *prometheus.Desc
-	httpRequests                           *prometheus.Desc
-	httpResponses                          *prometheus.Desc
-	tcpCurrentClientConnections            *prometheus.Desc
-	tcpCurrentClientConnectionsEstablished *prometheus.Desc
-	tcpCurrentServerConnections            *prometheus.Desc
-	tcpCurrentServerConnectionsEstablished *prometheus.Desc
-	interfacesRxBytes                      *prometheus.CounterVec
-	interfacesTxBytes                      *prometheus.CounterVec
-	interfacesRxPackets                    *prometheus.CounterVec
-	interfacesTxPackets                    *prometheus.CounterVec
-	interfacesJumboPacketsRx               *prometheus.CounterVec
-	interfacesJumboPacketsTx               *prometheus.CounterVec
-	interfacesErrorPacketsRx               *prometheus.CounterVec
-	virtualServersWaitingRequests          *prometheus.GaugeVec
-	virtualServersHealth                   *prometheus.GaugeVec
-	virtualServersInactiveServices         *prometheus.GaugeVec
-	virtualServersActiveServices           *prometheus.GaugeVec
-	virtualServersTotalHits                *prometheus.CounterVec
-	virtualServersTotalRequests            *prometheus.CounterVec
-	virtualServersTotalResponses           *prometheus.CounterVec
-	virtualServersTotalRequestBytes        *prometheus.CounterVec
-	virtualServersTotalResponseBytes       *prometheus.CounterVec
-	virtualServersCurrentClientConnections *prometheus.GaugeVec
-	virtualServersCurrentServerConnections *prometheus.GaugeVec
-	virtualServersState                    *prometheus.GaugeVec
-	servicesThroughput                     *prometheus.CounterVec
-	servicesAvgTTFB                        *prometheus.GaugeVec
-	servicesState                          *prometheus.GaugeVec
-	servicesTotalRequests                  *prometheus.CounterVec
-	servicesTotalResponses                 *prometheus.CounterVec
-	servicesTotalRequestBytes              *prometheus.CounterVec
-	servicesTotalResponseBytes             *prometheus.CounterVec
-	servicesCurrentClientConns             *prometheus.GaugeVec
-	servicesSurgeCount                     *prometheus.GaugeVec
-	servicesCurrentServerConns             *prometheus.GaugeVec
-	servicesServerEstablishedConnections   *prometheus.GaugeVec
-	servicesCurrentReusePool               *prometheus.GaugeVec
-	servicesMaxClients                     *prometheus.GaugeVec
+	modelID                                           *prometheus.Desc
+	mgmtCPUUsage                                      *prometheus.Desc
+	memUsage                                          *prometheus.Desc
+	pktCPUUsage                                       *prometheus.Desc
+	flashPartitionUsage                               *prometheus.Desc
+	varPartitionUsage                                 *prometheus.Desc
+	totRxBytes                                        *prometheus.Desc
+	totTxBytes                                        *prometheus.Desc
+	httpRequests                                      *prometheus.Desc
+	httpResponses                                     *prometheus.Desc
+	tcpCurrentClientConnections                       *prometheus.Desc
+	tcpCurrentClientConnectionsEstablished            *prometheus.Desc
+	tcpCurrentServerConnections                       *prometheus.Desc
+	tcpCurrentServerConnectionsEstablished            *prometheus.Desc
+	interfacesRxBytes                                 *prometheus.CounterVec
+	interfacesTxBytes                                 *prometheus.CounterVec
+	interfacesRxPackets                               *prometheus.CounterVec
+	interfacesTxPackets                               *prometheus.CounterVec
+	interfacesJumboPacketsRx                          *prometheus.CounterVec
+	interfacesJumboPacketsTx                          *prometheus.CounterVec
+	interfacesErrorPacketsRx                          *prometheus.CounterVec
+	interfacesErrorPacketsTx                          *prometheus.CounterVec
+	interfacesDroppedPacketsRx                        *prometheus.CounterVec
+	interfacesDroppedPacketsTx                        *prometheus.CounterVec
+	interfacesMulticastPackets                        *prometheus.CounterVec
+	interfacesLinkHangs                               *prometheus.CounterVec
+	interfacesNICRxStalls                             *prometheus.CounterVec
+	interfacesNICTxStalls                             *prometheus.CounterVec
+	interfacesLinkReinits                             *prometheus.CounterVec
+	interfacesLinkUptime                              *prometheus.GaugeVec
+	interfacesLinkState                               *prometheus.GaugeVec
+	interfacesActualSpeed                             *prometheus.GaugeVec
+	interfacesRequestedSpeed                          *prometheus.GaugeVec
+	interfacesDuplex                                  *prometheus.GaugeVec
+	interfacesChannelInfo                             *prometheus.GaugeVec
+	virtualServersWaitingRequests                     *prometheus.GaugeVec
+	virtualServersHealth                              *prometheus.GaugeVec
+	virtualServersInactiveServices                    *prometheus.GaugeVec
+	virtualServersActiveServices                      *prometheus.GaugeVec
+	virtualServersTotalHits                           *prometheus.CounterVec
+	virtualServersTotalRequests                       *prometheus.CounterVec
+	virtualServersTotalResponses                      *prometheus.CounterVec
+	virtualServersTotalRequestBytes                   *prometheus.CounterVec
+	virtualServersTotalResponseBytes                  *prometheus.CounterVec
+	virtualServersCurrentClientConnections            *prometheus.GaugeVec
+	virtualServersCurrentServerConnections            *prometheus.GaugeVec
+	virtualServersState                               *prometheus.GaugeVec
+	virtualServersSurgeCount                          *prometheus.GaugeVec
+	virtualServersTotalSpillovers                     *prometheus.CounterVec
+	virtualServersTotalVServerDownBackupHits          *prometheus.CounterVec
+	virtualServersAvgClientTTLB                       *prometheus.GaugeVec
+	virtualServersNumberInvalidRequestResponse        *prometheus.CounterVec
+	virtualServersNumberInvalidRequestResponseDropped *prometheus.CounterVec
+	virtualServersTotalPacketsReceived                *prometheus.CounterVec
+	virtualServersTotalPacketsSent                    *prometheus.CounterVec
+	virtualServersEstablishedConnections              *prometheus.GaugeVec
+	virtualServersDeferredRequests                    *prometheus.CounterVec
+	virtualServersTotalServerBusyErrors               *prometheus.CounterVec
+	servicesThroughput                                *prometheus.CounterVec
+	servicesAvgTTFB                                   *prometheus.GaugeVec
+	servicesState                                     *prometheus.GaugeVec
+	servicesTotalRequests                             *prometheus.CounterVec
+	servicesTotalResponses                            *prometheus.CounterVec
+	servicesTotalRequestBytes                         *prometheus.CounterVec
+	servicesTotalResponseBytes                        *prometheus.CounterVec
+	servicesCurrentClientConns                        *prometheus.GaugeVec
+	servicesSurgeCount                                *prometheus.GaugeVec
+	servicesCurrentServerConns                        *prometheus.GaugeVec
+	servicesServerEstablishedConnections              *prometheus.GaugeVec
+	servicesCurrentReusePool                          *prometheus.GaugeVec
+	servicesMaxClients                                *prometheus.GaugeVec
 	//servicesCurrentLoad                    *prometheus.GaugeVec
 	//servicesVirtualServerServiceHits       *prometheus.CounterVec
 	servicesActiveTransactions                *prometheus.GaugeVec
@@ -114,21 +139,267 @@ type Exporter struct {
 	csVirtualServersTotalVServerDownBackupHits          *prometheus.CounterVec
 	//csVirtualServersCurrentMultipathSessions            *prometheus.GaugeVec
 	//csVirtualServersCurrentMultipathSubflows            *prometheus.GaugeVec
-	vpnVirtualServersTotalRequests      *prometheus.CounterVec
-	vpnVirtualServersTotalResponses     *prometheus.CounterVec
-	vpnVirtualServersTotalRequestBytes  *prometheus.CounterVec
-	vpnVirtualServersTotalResponseBytes *prometheus.CounterVec
-	vpnVirtualServersState              *prometheus.GaugeVec
-	username                            string
-	password                            string
-	url                                 string
-	ignoreCert                          bool
-	logger                              log.Logger
-	nsInstance                          string
+	vpnVirtualServersTotalRequests                 *prometheus.CounterVec
+	vpnVirtualServersTotalResponses                *prometheus.CounterVec
+	vpnVirtualServersTotalRequestBytes             *prometheus.CounterVec
+	vpnVirtualServersTotalResponseBytes            *prometheus.CounterVec
+	vpnVirtualServersState                         *prometheus.GaugeVec
+	systemMemoryAllocatedPct                       *prometheus.GaugeVec
+	systemMemoryAllocatedBytes                     *prometheus.GaugeVec
+	systemMemorySizeBytes                          *prometheus.GaugeVec
+	systemMemoryFreeBytes                          *prometheus.GaugeVec
+	systemMemoryAllocationFailures                 *prometheus.CounterVec
+	hardwareTemperature                            *prometheus.GaugeVec
+	hardwareFanSpeed                               *prometheus.GaugeVec
+	hardwarePowerSupplyStatus                      *prometheus.GaugeVec
+	hardwareDiskUsage                              *prometheus.GaugeVec
+	hardwareDiskSize                               *prometheus.GaugeVec
+	hardwareDiskAvailable                          *prometheus.GaugeVec
+	serversState                                   *prometheus.GaugeVec
+	serversDomainIPAddress                         *prometheus.GaugeVec
+	serversBoundServices                           *prometheus.GaugeVec
+	serversBoundServiceGroups                      *prometheus.GaugeVec
+	monitorsServiceState                           *prometheus.GaugeVec
+	monitorsServiceResponseTime                    *prometheus.GaugeVec
+	monitorsServiceProbes                          *prometheus.CounterVec
+	monitorsServiceFailedProbes                    *prometheus.CounterVec
+	monitorsServiceCurrentFailedProbes             *prometheus.GaugeVec
+	monitorsServiceLastResponse                    *prometheus.GaugeVec
+	monitorsServiceGroupState                      *prometheus.GaugeVec
+	monitorsServiceGroupMemberState                *prometheus.GaugeVec
+	monitorsServiceGroupMemberResponseTime         *prometheus.GaugeVec
+	monitorsServiceGroupMemberProbes               *prometheus.CounterVec
+	monitorsServiceGroupMemberFailedProbes         *prometheus.CounterVec
+	monitorsServiceGroupMemberCurrentFailedProbes  *prometheus.GaugeVec
+	monitorsServiceGroupMemberLastResponse         *prometheus.GaugeVec
+	cacheTotalHits                                 *prometheus.CounterVec
+	cacheTotalMisses                               *prometheus.CounterVec
+	cacheTotalRequests                             *prometheus.CounterVec
+	cacheTotal304Hits                              *prometheus.CounterVec
+	cacheTotalStoreableMisses                      *prometheus.CounterVec
+	cacheTotalNonStoreableMisses                   *prometheus.CounterVec
+	cacheTotalFlashCacheHits                       *prometheus.CounterVec
+	cacheTotalFlashCacheMisses                     *prometheus.CounterVec
+	cacheServedBytes                               *prometheus.CounterVec
+	cacheOriginBandwidthSaved                      *prometheus.GaugeVec
+	cacheCachedObjects                             *prometheus.GaugeVec
+	cacheMemoryUsed                                *prometheus.GaugeVec
+	cacheMemoryMax                                 *prometheus.GaugeVec
+	cacheContentGroupMemoryUsed                    *prometheus.GaugeVec
+	cacheContentGroupCachedObjects                 *prometheus.GaugeVec
+	cacheContentGroupHits                          *prometheus.CounterVec
+	cacheContentGroupMisses                        *prometheus.CounterVec
+	compressionHTTPTotalRequests                   *prometheus.CounterVec
+	compressionHTTPTotalRxBytes                    *prometheus.CounterVec
+	compressionHTTPTotalTxBytes                    *prometheus.CounterVec
+	compressionHTTPTotalRxPackets                  *prometheus.CounterVec
+	compressionHTTPTotalTxPackets                  *prometheus.CounterVec
+	compressionHTTPRatio                           *prometheus.GaugeVec
+	compressionHTTPBandwidthSaving                 *prometheus.GaugeVec
+	compressionTCPTotalRxBytes                     *prometheus.CounterVec
+	compressionTCPTotalTxBytes                     *prometheus.CounterVec
+	compressionTCPRatio                            *prometheus.GaugeVec
+	compressionTCPBandwidthSaving                  *prometheus.GaugeVec
+	compressionPolicyHits                          *prometheus.CounterVec
+	appfwRequests                                  *prometheus.CounterVec
+	appfwResponses                                 *prometheus.CounterVec
+	appfwRequestBytes                              *prometheus.CounterVec
+	appfwResponseBytes                             *prometheus.CounterVec
+	appfwAborts                                    *prometheus.CounterVec
+	appfwRedirects                                 *prometheus.CounterVec
+	appfwViolations                                *prometheus.CounterVec
+	appfwCheckViolations                           *prometheus.CounterVec
+	appfwProfileRequests                           *prometheus.CounterVec
+	appfwProfileResponses                          *prometheus.CounterVec
+	appfwProfileAborts                             *prometheus.CounterVec
+	appfwProfileRedirects                          *prometheus.CounterVec
+	appfwProfileViolations                         *prometheus.CounterVec
+	appfwProfileCheckViolations                    *prometheus.CounterVec
+	policyHits                                     *prometheus.CounterVec
+	policyUndefHits                                *prometheus.CounterVec
+	policyBindingInfo                              *prometheus.GaugeVec
+	protocolTCPRxPackets                           *prometheus.CounterVec
+	protocolTCPRxBytes                             *prometheus.CounterVec
+	protocolTCPTxPackets                           *prometheus.CounterVec
+	protocolTCPTxBytes                             *prometheus.CounterVec
+	protocolTCPClientConnections                   *prometheus.GaugeVec
+	protocolTCPClientConnectionsEstablished        *prometheus.GaugeVec
+	protocolTCPServerConnections                   *prometheus.GaugeVec
+	protocolTCPServerConnectionsEstablished        *prometheus.GaugeVec
+	protocolTCPSurgeQueueLength                    *prometheus.GaugeVec
+	protocolTCPSpareConnections                    *prometheus.GaugeVec
+	protocolTCPSyn                                 *prometheus.CounterVec
+	protocolTCPSynProbes                           *prometheus.CounterVec
+	protocolTCPSynHeld                             *prometheus.CounterVec
+	protocolTCPSynDroppedCongestion                *prometheus.CounterVec
+	protocolTCPSynRetries                          *prometheus.CounterVec
+	protocolTCPSynGiveUps                          *prometheus.CounterVec
+	protocolTCPSynCookieSeqRejects                 *prometheus.CounterVec
+	protocolTCPSynCookieSignatureRejects           *prometheus.CounterVec
+	protocolTCPSynCookieSeqDrops                   *prometheus.CounterVec
+	protocolTCPSynCookieMSSRejects                 *prometheus.CounterVec
+	protocolTCPRetransmits                         *prometheus.CounterVec
+	protocolTCPFirstRetransmits                    *prometheus.CounterVec
+	protocolTCPClientRetransmits                   *prometheus.CounterVec
+	protocolTCPServerRetransmits                   *prometheus.CounterVec
+	protocolTCPRetransmitGiveUps                   *prometheus.CounterVec
+	protocolTCPRstReceived                         *prometheus.CounterVec
+	protocolTCPRstSent                             *prometheus.CounterVec
+	protocolTCPRstOutOfWindow                      *prometheus.CounterVec
+	protocolTCPRstNonEstablished                   *prometheus.CounterVec
+	protocolTCPBadChecksums                        *prometheus.CounterVec
+	protocolTCPStrayPackets                        *prometheus.CounterVec
+	protocolTCPClientOutOfOrder                    *prometheus.CounterVec
+	protocolTCPServerOutOfOrder                    *prometheus.CounterVec
+	protocolHTTPRequests                           *prometheus.CounterVec
+	protocolHTTPResponses                          *prometheus.CounterVec
+	protocolHTTPGetRequests                        *prometheus.CounterVec
+	protocolHTTPPostRequests                       *prometheus.CounterVec
+	protocolHTTPOtherRequests                      *prometheus.CounterVec
+	protocolHTTPRequestBytes                       *prometheus.CounterVec
+	protocolHTTPResponseBytes                      *prometheus.CounterVec
+	protocolHTTPHTTP10Requests                     *prometheus.CounterVec
+	protocolHTTPHTTP11Requests                     *prometheus.CounterVec
+	protocolHTTPChunkedRequests                    *prometheus.CounterVec
+	protocolHTTPChunkedResponses                   *prometheus.CounterVec
+	protocolHTTPIncompleteRequests                 *prometheus.CounterVec
+	protocolHTTPIncompleteResponses                *prometheus.CounterVec
+	protocolHTTPIncompleteHeaders                  *prometheus.CounterVec
+	protocolHTTPLargeContent                       *prometheus.CounterVec
+	protocolHTTPLargeChunk                         *prometheus.CounterVec
+	protocolHTTPInvalidContentLength               *prometheus.CounterVec
+	protocolHTTPServerBusy                         *prometheus.CounterVec
+	protocolIPRxPackets                            *prometheus.CounterVec
+	protocolIPRxBytes                              *prometheus.CounterVec
+	protocolIPTxPackets                            *prometheus.CounterVec
+	protocolIPTxBytes                              *prometheus.CounterVec
+	protocolIPFragments                            *prometheus.CounterVec
+	protocolIPReassemblyAttempts                   *prometheus.CounterVec
+	protocolIPReassembled                          *prometheus.CounterVec
+	protocolIPReassemblyFailures                   *prometheus.CounterVec
+	protocolIPTooBig                               *prometheus.CounterVec
+	protocolIPDuplicateFragments                   *prometheus.CounterVec
+	protocolIPOutOfOrderFragments                  *prometheus.CounterVec
+	protocolIPZeroLengthFragments                  *prometheus.CounterVec
+	protocolIPBadChecksums                         *prometheus.CounterVec
+	protocolIPTTLExpired                           *prometheus.CounterVec
+	protocolIPAddressLookups                       *prometheus.CounterVec
+	protocolIPAddressLookupFailures                *prometheus.CounterVec
+	protocolUDPRxPackets                           *prometheus.CounterVec
+	protocolUDPRxBytes                             *prometheus.CounterVec
+	protocolUDPTxPackets                           *prometheus.CounterVec
+	protocolUDPTxBytes                             *prometheus.CounterVec
+	protocolUDPUnknownServicePackets               *prometheus.CounterVec
+	protocolUDPBadChecksums                        *prometheus.CounterVec
+	protocolUDPRateThresholdExceeded               *prometheus.CounterVec
+	protocolICMPRxPackets                          *prometheus.CounterVec
+	protocolICMPRxBytes                            *prometheus.CounterVec
+	protocolICMPTxPackets                          *prometheus.CounterVec
+	protocolICMPTxBytes                            *prometheus.CounterVec
+	protocolICMPEchoRequestsReceived               *prometheus.CounterVec
+	protocolICMPEchoRepliesSent                    *prometheus.CounterVec
+	protocolICMPEchoRequestsSent                   *prometheus.CounterVec
+	protocolICMPEchoRepliesReceived                *prometheus.CounterVec
+	protocolICMPRateThresholdExceeded              *prometheus.CounterVec
+	protocolICMPBadChecksums                       *prometheus.CounterVec
+	dnsQueries                                     *prometheus.CounterVec
+	dnsResponses                                   *prometheus.CounterVec
+	dnsServerQueries                               *prometheus.CounterVec
+	dnsServerResponses                             *prometheus.CounterVec
+	dnsNXDomain                                    *prometheus.CounterVec
+	dnsCacheHits                                   *prometheus.CounterVec
+	dnsCacheMisses                                 *prometheus.CounterVec
+	dnsQueriesByType                               *prometheus.CounterVec
+	gslbSitesState                                 *prometheus.GaugeVec
+	gslbSitesMetricExchangeStatus                  *prometheus.GaugeVec
+	gslbSitesNetworkMetricExchangeStatus           *prometheus.GaugeVec
+	gslbSitesTotalRequests                         *prometheus.CounterVec
+	gslbSitesTotalResponses                        *prometheus.CounterVec
+	gslbSitesTotalRequestBytes                     *prometheus.CounterVec
+	gslbSitesTotalResponseBytes                    *prometheus.CounterVec
+	gslbSitesCurrentClientConns                    *prometheus.GaugeVec
+	gslbSitesCurrentServerConns                    *prometheus.GaugeVec
+	aaaCurrentSessions                             *prometheus.GaugeVec
+	aaaCurrentICASessions                          *prometheus.GaugeVec
+	aaaCurrentICAConnections                       *prometheus.GaugeVec
+	aaaCurrentICAOnlyConnections                   *prometheus.GaugeVec
+	aaaAuthSuccess                                 *prometheus.CounterVec
+	aaaAuthFailures                                *prometheus.CounterVec
+	aaaSessionsTotal                               *prometheus.CounterVec
+	aaaSessionTimeouts                             *prometheus.CounterVec
+	aaaCurrentTMSessions                           *prometheus.GaugeVec
+	aaaTMSessionsTotal                             *prometheus.CounterVec
+	aaaHTTPAuthSuccess                             *prometheus.CounterVec
+	aaaHTTPAuthFailures                            *prometheus.CounterVec
+	aaaNonHTTPAuthSuccess                          *prometheus.CounterVec
+	aaaNonHTTPAuthFailures                         *prometheus.CounterVec
+	authenticationVirtualServersState              *prometheus.GaugeVec
+	authenticationVirtualServersTotalRequests      *prometheus.CounterVec
+	authenticationVirtualServersTotalResponses     *prometheus.CounterVec
+	authenticationVirtualServersTotalRequestBytes  *prometheus.CounterVec
+	authenticationVirtualServersTotalResponseBytes *prometheus.CounterVec
+	vpnLoginPageHits                               *prometheus.CounterVec
+	vpnICALicenseFailures                          *prometheus.CounterVec
+	vpnSTARequests                                 *prometheus.CounterVec
+	vpnSTAConnectionSuccess                        *prometheus.CounterVec
+	vpnSTAConnectionFailures                       *prometheus.CounterVec
+	channelsMembers                                *prometheus.GaugeVec
+	channelsState                                  *prometheus.GaugeVec
+	channelsMemberState                            *prometheus.GaugeVec
+	channelsMemberLACPActorInSync                  *prometheus.GaugeVec
+	channelsMemberLACPPartnerInSync                *prometheus.GaugeVec
+	channelsMemberLACPPartnerCollecting            *prometheus.GaugeVec
+	channelsMemberLACPPartnerDistributing          *prometheus.GaugeVec
+	vlansRxPackets                                 *prometheus.CounterVec
+	vlansRxBytes                                   *prometheus.CounterVec
+	vlansTxPackets                                 *prometheus.CounterVec
+	vlansTxBytes                                   *prometheus.CounterVec
+	vlansDroppedPackets                            *prometheus.CounterVec
+	vlansBroadcastPackets                          *prometheus.CounterVec
+	buildInfo                                      *prometheus.GaugeVec
+	uptimeSeconds                                  *prometheus.GaugeVec
+	bootTimeSeconds                                *prometheus.GaugeVec
+	licenseFeatureEnabled                          *prometheus.GaugeVec
+	licenseExpiryDays                              *prometheus.GaugeVec
+	licenseThroughput                              *prometheus.GaugeVec
+	licenseActualThroughput                        *prometheus.GaugeVec
+	configUnsavedChanges                           *prometheus.GaugeVec
+	configLastSaveTimestamp                        *prometheus.GaugeVec
+	configLastChangeTimestamp                      *prometheus.GaugeVec
+	partitionsCurrentBandwidth                     *prometheus.GaugeVec
+	partitionsMaxBandwidth                         *prometheus.GaugeVec
+	partitionsCurrentConnections                   *prometheus.GaugeVec
+	partitionsMaxConnections                       *prometheus.GaugeVec
+	partitionsMemoryUsage                          *prometheus.GaugeVec
+	partitionsMaxMemory                            *prometheus.GaugeVec
+	trafficDomainsState                            *prometheus.GaugeVec
+	trafficDomainsEntities                         *prometheus.GaugeVec
+	trafficDomainsCurrentClientConnections         *prometheus.GaugeVec
+	virtualServersInfo                             *prometheus.GaugeVec
+	csVirtualServersInfo                           *prometheus.GaugeVec
+	gslbVirtualServersInfo                         *prometheus.GaugeVec
+	servicesInfo                                   *prometheus.GaugeVec
+	serviceGroupsMemberInfo                        *prometheus.GaugeVec
+	persistenceSessions                            *prometheus.GaugeVec
+	connectionTableConnections                     *prometheus.GaugeVec
+	limitIdentifiersHits                           *prometheus.CounterVec
+	limitIdentifiersDrops                          *prometheus.CounterVec
+	limitIdentifiersCurrentSessions                *prometheus.GaugeVec
+	limitIdentifiersThreshold                      *prometheus.GaugeVec
+	botProfileDetections                           *prometheus.CounterVec
+	botProfileActions                              *prometheus.CounterVec
+	trafficDomainMembers                           map[string]map[string]string
+	username                                       string
+	password                                       string
+	url                                            string
+	ignoreCert                                     bool
+	partition                                      string
+	logger                                         log.Logger
+	nsInstance                                     string
 }
 
 // NewExporter initialises the exporter
-func NewExporter(url string, username string, password string, ignoreCert bool, logger log.Logger, nsInstance string) (*Exporter, error) {
+func NewExporter(url string, username string, password string, ignoreCert bool, partition string, logger log.Logger, nsInstance string) (*Exporter, error) {
 	if url == "" {
 		return nil, errors.New("no Url Specified")
 	}
@@ -142,52 +413,77 @@ func NewExporter(url string, username string, password string, ignoreCert bool,
 	}
 
 	return &Exporter{
-		modelID:                                modelID,
-		mgmtCPUUsage:                           mgmtCPUUsage,
-		memUsage:                               memUsage,
-		pktCPUUsage:                            pktCPUUsage,
-		flashPartitionUsage:                    flashPartitionUsage,
-		varPartitionUsage:                      varPartitionUsage,
-		totRxBytes:                             totRxBytes,
-		totTxBytes:                             totTxBytes,
-		httpRequests:                           httpRequests,
-		httpResponses:                          httpResponses,
-		tcpCurrentClientConnections:            tcpCurrentClientConnections,
-		tcpCurrentClientConnectionsEstablished: tcpCurrentClientConnectionsEstablished,
-		tcpCurrentServerConnections:            tcpCurrentServerConnections,
-		tcpCurrentServerConnectionsEstablished: tcpCurrentServerConnectionsEstablished,
-		interfacesRxBytes:                      interfacesRxBytes,
-		interfacesTxBytes:                      interfacesTxBytes,
-		interfacesRxPackets:                    interfacesRxPackets,
-		interfacesTxPackets:                    interfacesTxPackets,
-		interfacesJumboPacketsRx:               interfacesJumboPacketsRx,
-		interfacesJumboPacketsTx:               interfacesJumboPacketsTx,
-		interfacesErrorPacketsRx:               interfacesErrorPacketsRx,
-		virtualServersWaitingRequests:          virtualServersWaitingRequests,
-		virtualServersHealth:                   virtualServersHealth,
-		virtualServersInactiveServices:         virtualServersInactiveServices,
-		virtualServersActiveServices:           virtualServersActiveServices,
-		virtualServersTotalHits:                virtualServersTotalHits,
-		virtualServersTotalRequests:            virtualServersTotalRequests,
-		virtualServersTotalResponses:           virtualServersTotalResponses,
-		virtualServersTotalRequestBytes:        virtualServersTotalRequestBytes,
-		virtualServersTotalResponseBytes:       virtualServersTotalResponseBytes,
-		virtualServersCurrentClientConnections: virtualServersCurrentClientConnections,
-		virtualServersCurrentServerConnections: virtualServersCurrentServerConnections,
-		virtualServersState:                    virtualServersState,
-		servicesThroughput:                     servicesThroughput,
-		servicesAvgTTFB:                        servicesAvgTTFB,
-		servicesState:                          servicesState,
-		servicesTotalRequests:                  servicesTotalRequests,
-		servicesTotalResponses:                 servicesTotalResponses,
-		servicesTotalRequestBytes:              servicesTotalRequestBytes,
-		servicesTotalResponseBytes:             servicesTotalResponseBytes,
-		servicesCurrentClientConns:             servicesCurrentClientConns,
-		servicesSurgeCount:                     servicesSurgeCount,
-		servicesCurrentServerConns:             servicesCurrentServerConns,
-		servicesServerEstablishedConnections:   servicesServerEstablishedConnections,
-		servicesCurrentReusePool:               servicesCurrentReusePool,
-		servicesMaxClients:                     servicesMaxClients,
+		modelID:                                           modelID,
+		mgmtCPUUsage:                                      mgmtCPUUsage,
+		memUsage:                                          memUsage,
+		pktCPUUsage:                                       pktCPUUsage,
+		flashPartitionUsage:                               flashPartitionUsage,
+		varPartitionUsage:                                 varPartitionUsage,
+		totRxBytes:                                        totRxBytes,
+		totTxBytes:                                        totTxBytes,
+		httpRequests:                                      httpRequests,
+		httpResponses:                                     httpResponses,
+		tcpCurrentClientConnections:                       tcpCurrentClientConnections,
+		tcpCurrentClientConnectionsEstablished:            tcpCurrentClientConnectionsEstablished,
+		tcpCurrentServerConnections:                       tcpCurrentServerConnections,
+		tcpCurrentServerConnectionsEstablished:            tcpCurrentServerConnectionsEstablished,
+		interfacesRxBytes:                                 interfacesRxBytes,
+		interfacesTxBytes:                                 interfacesTxBytes,
+		interfacesRxPackets:                               interfacesRxPackets,
+		interfacesTxPackets:                               interfacesTxPackets,
+		interfacesJumboPacketsRx:                          interfacesJumboPacketsRx,
+		interfacesJumboPacketsTx:                          interfacesJumboPacketsTx,
+		interfacesErrorPacketsRx:                          interfacesErrorPacketsRx,
+		interfacesErrorPacketsTx:                          interfacesErrorPacketsTx,
+		interfacesDroppedPacketsRx:                        interfacesDroppedPacketsRx,
+		interfacesDroppedPacketsTx:                        interfacesDroppedPacketsTx,
+		interfacesMulticastPackets:                        interfacesMulticastPackets,
+		interfacesLinkHangs:                               interfacesLinkHangs,
+		interfacesNICRxStalls:                             interfacesNICRxStalls,
+		interfacesNICTxStalls:                             interfacesNICTxStalls,
+		interfacesLinkReinits:                             interfacesLinkReinits,
+		interfacesLinkUptime:                              interfacesLinkUptime,
+		interfacesLinkState:                               interfacesLinkState,
+		interfacesActualSpeed:                             interfacesActualSpeed,
+		interfacesRequestedSpeed:                          interfacesRequestedSpeed,
+		interfacesDuplex:                                  interfacesDuplex,
+		interfacesChannelInfo:                             interfacesChannelInfo,
+		virtualServersWaitingRequests:                     virtualServersWaitingRequests,
+		virtualServersHealth:                              virtualServersHealth,
+		virtualServersInactiveServices:                    virtualServersInactiveServices,
+		virtualServersActiveServices:                      virtualServersActiveServices,
+		virtualServersTotalHits:                           virtualServersTotalHits,
+		virtualServersTotalRequests:                       virtualServersTotalRequests,
+		virtualServersTotalResponses:                      virtualServersTotalResponses,
+		virtualServersTotalRequestBytes:                   virtualServersTotalRequestBytes,
+		virtualServersTotalResponseBytes:                  virtualServersTotalResponseBytes,
+		virtualServersCurrentClientConnections:            virtualServersCurrentClientConnections,
+		virtualServersCurrentServerConnections:            virtualServersCurrentServerConnections,
+		virtualServersState:                               virtualServersState,
+		virtualServersSurgeCount:                          virtualServersSurgeCount,
+		virtualServersTotalSpillovers:                     virtualServersTotalSpillovers,
+		virtualServersTotalVServerDownBackupHits:          virtualServersTotalVServerDownBackupHits,
+		virtualServersAvgClientTTLB:                       virtualServersAvgClientTTLB,
+		virtualServersNumberInvalidRequestResponse:        virtualServersNumberInvalidRequestResponse,
+		virtualServersNumberInvalidRequestResponseDropped: virtualServersNumberInvalidRequestResponseDropped,
+		virtualServersTotalPacketsReceived:                virtualServersTotalPacketsReceived,
+		virtualServersTotalPacketsSent:                    virtualServersTotalPacketsSent,
+		virtualServersEstablishedConnections:              virtualServersEstablishedConnections,
+		virtualServersDeferredRequests:                    virtualServersDeferredRequests,
+		virtualServersTotalServerBusyErrors:               virtualServersTotalServerBusyErrors,
+		servicesThroughput:                                servicesThroughput,
+		servicesAvgTTFB:                                   servicesAvgTTFB,
+		servicesState:                                     servicesState,
+		servicesTotalRequests:                             servicesTotalRequests,
+		servicesTotalResponses:                            servicesTotalResponses,
+		servicesTotalRequestBytes:                         servicesTotalRequestBytes,
+		servicesTotalResponseBytes:                        servicesTotalResponseBytes,
+		servicesCurrentClientConns:                        servicesCurrentClientConns,
+		servicesSurgeCount:                                servicesSurgeCount,
+		servicesCurrentServerConns:                        servicesCurrentServerConns,
+		servicesServerEstablishedConnections:              servicesServerEstablishedConnections,
+		servicesCurrentReusePool:                          servicesCurrentReusePool,
+		servicesMaxClients:                                servicesMaxClients,
 		//servicesCurrentLoad:                    servicesCurrentLoad,
 		//servicesVirtualServerServiceHits:                    servicesVirtualServerServiceHits,
 		servicesActiveTransactions:                servicesActiveTransactions,
@@ -241,17 +537,262 @@ func NewExporter(url string, username string, password string, ignoreCert bool,
 		csVirtualServersTotalVServerDownBackupHits:          csVirtualServersTotalVServerDownBackupHits,
 		//csVirtualServersCurrentMultipathSessions:            csVirtualServersCurrentMultipathSessions,
 		//csVirtualServersCurrentMultipathSubflows:            csVirtualServersCurrentMultipathSubflows,
-		vpnVirtualServersTotalRequests:      vpnVirtualServersTotalRequests,
-		vpnVirtualServersTotalResponses:     vpnVirtualServersTotalResponses,
-		vpnVirtualServersTotalRequestBytes:  vpnVirtualServersTotalRequestBytes,
-		vpnVirtualServersTotalResponseBytes: vpnVirtualServersTotalResponseBytes,
-		vpnVirtualServersState:              vpnVirtualServersState,
-		username:                            username,
-		password:                            password,
-		url:                                 url,
-		ignoreCert:                          ignoreCert,
-		logger:                              logger,
-		nsInstance:                          nsInstance,
+		vpnVirtualServersTotalRequests:                 vpnVirtualServersTotalRequests,
+		vpnVirtualServersTotalResponses:                vpnVirtualServersTotalResponses,
+		vpnVirtualServersTotalRequestBytes:             vpnVirtualServersTotalRequestBytes,
+		vpnVirtualServersTotalResponseBytes:            vpnVirtualServersTotalResponseBytes,
+		vpnVirtualServersState:                         vpnVirtualServersState,
+		systemMemoryAllocatedPct:                       systemMemoryAllocatedPct,
+		systemMemoryAllocatedBytes:                     systemMemoryAllocatedBytes,
+		systemMemorySizeBytes:                          systemMemorySizeBytes,
+		systemMemoryFreeBytes:                          systemMemoryFreeBytes,
+		systemMemoryAllocationFailures:                 systemMemoryAllocationFailures,
+		hardwareTemperature:                            hardwareTemperature,
+		hardwareFanSpeed:                               hardwareFanSpeed,
+		hardwarePowerSupplyStatus:                      hardwarePowerSupplyStatus,
+		hardwareDiskUsage:                              hardwareDiskUsage,
+		hardwareDiskSize:                               hardwareDiskSize,
+		hardwareDiskAvailable:                          hardwareDiskAvailable,
+		serversState:                                   serversState,
+		serversDomainIPAddress:                         serversDomainIPAddress,
+		serversBoundServices:                           serversBoundServices,
+		serversBoundServiceGroups:                      serversBoundServiceGroups,
+		monitorsServiceState:                           monitorsServiceState,
+		monitorsServiceResponseTime:                    monitorsServiceResponseTime,
+		monitorsServiceProbes:                          monitorsServiceProbes,
+		monitorsServiceFailedProbes:                    monitorsServiceFailedProbes,
+		monitorsServiceCurrentFailedProbes:             monitorsServiceCurrentFailedProbes,
+		monitorsServiceLastResponse:                    monitorsServiceLastResponse,
+		monitorsServiceGroupState:                      monitorsServiceGroupState,
+		monitorsServiceGroupMemberState:                monitorsServiceGroupMemberState,
+		monitorsServiceGroupMemberResponseTime:         monitorsServiceGroupMemberResponseTime,
+		monitorsServiceGroupMemberProbes:               monitorsServiceGroupMemberProbes,
+		monitorsServiceGroupMemberFailedProbes:         monitorsServiceGroupMemberFailedProbes,
+		monitorsServiceGroupMemberCurrentFailedProbes:  monitorsServiceGroupMemberCurrentFailedProbes,
+		monitorsServiceGroupMemberLastResponse:         monitorsServiceGroupMemberLastResponse,
+		cacheTotalHits:                                 cacheTotalHits,
+		cacheTotalMisses:                               cacheTotalMisses,
+		cacheTotalRequests:                             cacheTotalRequests,
+		cacheTotal304Hits:                              cacheTotal304Hits,
+		cacheTotalStoreableMisses:                      cacheTotalStoreableMisses,
+		cacheTotalNonStoreableMisses:                   cacheTotalNonStoreableMisses,
+		cacheTotalFlashCacheHits:                       cacheTotalFlashCacheHits,
+		cacheTotalFlashCacheMisses:                     cacheTotalFlashCacheMisses,
+		cacheServedBytes:                               cacheServedBytes,
+		cacheOriginBandwidthSaved:                      cacheOriginBandwidthSaved,
+		cacheCachedObjects:                             cacheCachedObjects,
+		cacheMemoryUsed:                                cacheMemoryUsed,
+		cacheMemoryMax:                                 cacheMemoryMax,
+		cacheContentGroupMemoryUsed:                    cacheContentGroupMemoryUsed,
+		cacheContentGroupCachedObjects:                 cacheContentGroupCachedObjects,
+		cacheContentGroupHits:                          cacheContentGroupHits,
+		cacheContentGroupMisses:                        cacheContentGroupMisses,
+		compressionHTTPTotalRequests:                   compressionHTTPTotalRequests,
+		compressionHTTPTotalRxBytes:                    compressionHTTPTotalRxBytes,
+		compressionHTTPTotalTxBytes:                    compressionHTTPTotalTxBytes,
+		compressionHTTPTotalRxPackets:                  compressionHTTPTotalRxPackets,
+		compressionHTTPTotalTxPackets:                  compressionHTTPTotalTxPackets,
+		compressionHTTPRatio:                           compressionHTTPRatio,
+		compressionHTTPBandwidthSaving:                 compressionHTTPBandwidthSaving,
+		compressionTCPTotalRxBytes:                     compressionTCPTotalRxBytes,
+		compressionTCPTotalTxBytes:                     compressionTCPTotalTxBytes,
+		compressionTCPRatio:                            compressionTCPRatio,
+		compressionTCPBandwidthSaving:                  compressionTCPBandwidthSaving,
+		compressionPolicyHits:                          compressionPolicyHits,
+		appfwRequests:                                  appfwRequests,
+		appfwResponses:                                 appfwResponses,
+		appfwRequestBytes:                              appfwRequestBytes,
+		appfwResponseBytes:                             appfwResponseBytes,
+		appfwAborts:                                    appfwAborts,
+		appfwRedirects:                                 appfwRedirects,
+		appfwViolations:                                appfwViolations,
+		appfwCheckViolations:                           appfwCheckViolations,
+		appfwProfileRequests:                           appfwProfileRequests,
+		appfwProfileResponses:                          appfwProfileResponses,
+		appfwProfileAborts:                             appfwProfileAborts,
+		appfwProfileRedirects:                          appfwProfileRedirects,
+		appfwProfileViolations:                         appfwProfileViolations,
+		appfwProfileCheckViolations:                    appfwProfileCheckViolations,
+		policyHits:                                     policyHits,
+		policyUndefHits:                                policyUndefHits,
+		policyBindingInfo:                              policyBindingInfo,
+		protocolTCPRxPackets:                           protocolTCPRxPackets,
+		protocolTCPRxBytes:                             protocolTCPRxBytes,
+		protocolTCPTxPackets:                           protocolTCPTxPackets,
+		protocolTCPTxBytes:                             protocolTCPTxBytes,
+		protocolTCPClientConnections:                   protocolTCPClientConnections,
+		protocolTCPClientConnectionsEstablished:        protocolTCPClientConnectionsEstablished,
+		protocolTCPServerConnections:                   protocolTCPServerConnections,
+		protocolTCPServerConnectionsEstablished:        protocolTCPServerConnectionsEstablished,
+		protocolTCPSurgeQueueLength:                    protocolTCPSurgeQueueLength,
+		protocolTCPSpareConnections:                    protocolTCPSpareConnections,
+		protocolTCPSyn:                                 protocolTCPSyn,
+		protocolTCPSynProbes:                           protocolTCPSynProbes,
+		protocolTCPSynHeld:                             protocolTCPSynHeld,
+		protocolTCPSynDroppedCongestion:                protocolTCPSynDroppedCongestion,
+		protocolTCPSynRetries:                          protocolTCPSynRetries,
+		protocolTCPSynGiveUps:                          protocolTCPSynGiveUps,
+		protocolTCPSynCookieSeqRejects:                 protocolTCPSynCookieSeqRejects,
+		protocolTCPSynCookieSignatureRejects:           protocolTCPSynCookieSignatureRejects,
+		protocolTCPSynCookieSeqDrops:                   protocolTCPSynCookieSeqDrops,
+		protocolTCPSynCookieMSSRejects:                 protocolTCPSynCookieMSSRejects,
+		protocolTCPRetransmits:                         protocolTCPRetransmits,
+		protocolTCPFirstRetransmits:                    protocolTCPFirstRetransmits,
+		protocolTCPClientRetransmits:                   protocolTCPClientRetransmits,
+		protocolTCPServerRetransmits:                   protocolTCPServerRetransmits,
+		protocolTCPRetransmitGiveUps:                   protocolTCPRetransmitGiveUps,
+		protocolTCPRstReceived:                         protocolTCPRstReceived,
+		protocolTCPRstSent:                             protocolTCPRstSent,
+		protocolTCPRstOutOfWindow:                      protocolTCPRstOutOfWindow,
+		protocolTCPRstNonEstablished:                   protocolTCPRstNonEstablished,
+		protocolTCPBadChecksums:                        protocolTCPBadChecksums,
+		protocolTCPStrayPackets:                        protocolTCPStrayPackets,
+		protocolTCPClientOutOfOrder:                    protocolTCPClientOutOfOrder,
+		protocolTCPServerOutOfOrder:                    protocolTCPServerOutOfOrder,
+		protocolHTTPRequests:                           protocolHTTPRequests,
+		protocolHTTPResponses:                          protocolHTTPResponses,
+		protocolHTTPGetRequests:                        protocolHTTPGetRequests,
+		protocolHTTPPostRequests:                       protocolHTTPPostRequests,
+		protocolHTTPOtherRequests:                      protocolHTTPOtherRequests,
+		protocolHTTPRequestBytes:                       protocolHTTPRequestBytes,
+		protocolHTTPResponseBytes:                      protocolHTTPResponseBytes,
+		protocolHTTPHTTP10Requests:                     protocolHTTPHTTP10Requests,
+		protocolHTTPHTTP11Requests:                     protocolHTTPHTTP11Requests,
+		protocolHTTPChunkedRequests:                    protocolHTTPChunkedRequests,
+		protocolHTTPChunkedResponses:                   protocolHTTPChunkedResponses,
+		protocolHTTPIncompleteRequests:                 protocolHTTPIncompleteRequests,
+		protocolHTTPIncompleteResponses:                protocolHTTPIncompleteResponses,
+		protocolHTTPIncompleteHeaders:                  protocolHTTPIncompleteHeaders,
+		protocolHTTPLargeContent:                       protocolHTTPLargeContent,
+		protocolHTTPLargeChunk:                         protocolHTTPLargeChunk,
+		protocolHTTPInvalidContentLength:               protocolHTTPInvalidContentLength,
+		protocolHTTPServerBusy:                         protocolHTTPServerBusy,
+		protocolIPRxPackets:                            protocolIPRxPackets,
+		protocolIPRxBytes:                              protocolIPRxBytes,
+		protocolIPTxPackets:                            protocolIPTxPackets,
+		protocolIPTxBytes:                              protocolIPTxBytes,
+		protocolIPFragments:                            protocolIPFragments,
+		protocolIPReassemblyAttempts:                   protocolIPReassemblyAttempts,
+		protocolIPReassembled:                          protocolIPReassembled,
+		protocolIPReassemblyFailures:                   protocolIPReassemblyFailures,
+		protocolIPTooBig:                               protocolIPTooBig,
+		protocolIPDuplicateFragments:                   protocolIPDuplicateFragments,
+		protocolIPOutOfOrderFragments:                  protocolIPOutOfOrderFragments,
+		protocolIPZeroLengthFragments:                  protocolIPZeroLengthFragments,
+		protocolIPBadChecksums:                         protocolIPBadChecksums,
+		protocolIPTTLExpired:                           protocolIPTTLExpired,
+		protocolIPAddressLookups:                       protocolIPAddressLookups,
+		protocolIPAddressLookupFailures:                protocolIPAddressLookupFailures,
+		protocolUDPRxPackets:                           protocolUDPRxPackets,
+		protocolUDPRxBytes:                             protocolUDPRxBytes,
+		protocolUDPTxPackets:                           protocolUDPTxPackets,
+		protocolUDPTxBytes:                             protocolUDPTxBytes,
+		protocolUDPUnknownServicePackets:               protocolUDPUnknownServicePackets,
+		protocolUDPBadChecksums:                        protocolUDPBadChecksums,
+		protocolUDPRateThresholdExceeded:               protocolUDPRateThresholdExceeded,
+		protocolICMPRxPackets:                          protocolICMPRxPackets,
+		protocolICMPRxBytes:                            protocolICMPRxBytes,
+		protocolICMPTxPackets:                          protocolICMPTxPackets,
+		protocolICMPTxBytes:                            protocolICMPTxBytes,
+		protocolICMPEchoRequestsReceived:               protocolICMPEchoRequestsReceived,
+		protocolICMPEchoRepliesSent:                    protocolICMPEchoRepliesSent,
+		protocolICMPEchoRequestsSent:                   protocolICMPEchoRequestsSent,
+		protocolICMPEchoRepliesReceived:                protocolICMPEchoRepliesReceived,
+		protocolICMPRateThresholdExceeded:              protocolICMPRateThresholdExceeded,
+		protocolICMPBadChecksums:                       protocolICMPBadChecksums,
+		dnsQueries:                                     dnsQueries,
+		dnsResponses:                                   dnsResponses,
+		dnsServerQueries:                               dnsServerQueries,
+		dnsServerResponses:                             dnsServerResponses,
+		dnsNXDomain:                                    dnsNXDomain,
+		dnsCacheHits:                                   dnsCacheHits,
+		dnsCacheMisses:                                 dnsCacheMisses,
+		dnsQueriesByType:                               dnsQueriesByType,
+		gslbSitesState:                                 gslbSitesState,
+		gslbSitesMetricExchangeStatus:                  gslbSitesMetricExchangeStatus,
+		gslbSitesNetworkMetricExchangeStatus:           gslbSitesNetworkMetricExchangeStatus,
+		gslbSitesTotalRequests:                         gslbSitesTotalRequests,
+		gslbSitesTotalResponses:                        gslbSitesTotalResponses,
+		gslbSitesTotalRequestBytes:                     gslbSitesTotalRequestBytes,
+		gslbSitesTotalResponseBytes:                    gslbSitesTotalResponseBytes,
+		gslbSitesCurrentClientConns:                    gslbSitesCurrentClientConns,
+		gslbSitesCurrentServerConns:                    gslbSitesCurrentServerConns,
+		aaaCurrentSessions:                             aaaCurrentSessions,
+		aaaCurrentICASessions:                          aaaCurrentICASessions,
+		aaaCurrentICAConnections:                       aaaCurrentICAConnections,
+		aaaCurrentICAOnlyConnections:                   aaaCurrentICAOnlyConnections,
+		aaaAuthSuccess:                                 aaaAuthSuccess,
+		aaaAuthFailures:                                aaaAuthFailures,
+		aaaSessionsTotal:                               aaaSessionsTotal,
+		aaaSessionTimeouts:                             aaaSessionTimeouts,
+		aaaCurrentTMSessions:                           aaaCurrentTMSessions,
+		aaaTMSessionsTotal:                             aaaTMSessionsTotal,
+		aaaHTTPAuthSuccess:                             aaaHTTPAuthSuccess,
+		aaaHTTPAuthFailures:                            aaaHTTPAuthFailures,
+		aaaNonHTTPAuthSuccess:                          aaaNonHTTPAuthSuccess,
+		aaaNonHTTPAuthFailures:                         aaaNonHTTPAuthFailures,
+		authenticationVirtualServersState:              authenticationVirtualServersState,
+		authenticationVirtualServersTotalRequests:      authenticationVirtualServersTotalRequests,
+		authenticationVirtualServersTotalResponses:     authenticationVirtualServersTotalResponses,
+		authenticationVirtualServersTotalRequestBytes:  authenticationVirtualServersTotalRequestBytes,
+		authenticationVirtualServersTotalResponseBytes: authenticationVirtualServersTotalResponseBytes,
+		vpnLoginPageHits:                               vpnLoginPageHits,
+		vpnICALicenseFailures:                          vpnICALicenseFailures,
+		vpnSTARequests:                                 vpnSTARequests,
+		vpnSTAConnectionSuccess:                        vpnSTAConnectionSuccess,
+		vpnSTAConnectionFailures:                       vpnSTAConnectionFailures,
+		channelsMembers:                                channelsMembers,
+		channelsState:                                  channelsState,
+		channelsMemberState:                            channelsMemberState,
+		channelsMemberLACPActorInSync:                  channelsMemberLACPActorInSync,
+		channelsMemberLACPPartnerInSync:                channelsMemberLACPPartnerInSync,
+		channelsMemberLACPPartnerCollecting:            channelsMemberLACPPartnerCollecting,
+		channelsMemberLACPPartnerDistributing:          channelsMemberLACPPartnerDistributing,
+		vlansRxPackets:                                 vlansRxPackets,
+		vlansRxBytes:                                   vlansRxBytes,
+		vlansTxPackets:                                 vlansTxPackets,
+		vlansTxBytes:                                   vlansTxBytes,
+		vlansDroppedPackets:                            vlansDroppedPackets,
+		vlansBroadcastPackets:                          vlansBroadcastPackets,
+		buildInfo:                                      buildInfo,
+		uptimeSeconds:                                  uptimeSeconds,
+		bootTimeSeconds:                                bootTimeSeconds,
+		licenseFeatureEnabled:                          licenseFeatureEnabled,
+		licenseExpiryDays:                              licenseExpiryDays,
+		licenseThroughput:                              licenseThroughput,
+		licenseActualThroughput:                        licenseActualThroughput,
+		configUnsavedChanges:                           configUnsavedChanges,
+		configLastSaveTimestamp:                        configLastSaveTimestamp,
+		configLastChangeTimestamp:                      configLastChangeTimestamp,
+		partitionsCurrentBandwidth:                     partitionsCurrentBandwidth,
+		partitionsMaxBandwidth:                         partitionsMaxBandwidth,
+		partitionsCurrentConnections:                   partitionsCurrentConnections,
+		partitionsMaxConnections:                       partitionsMaxConnections,
+		partitionsMemoryUsage:                          partitionsMemoryUsage,
+		partitionsMaxMemory:                            partitionsMaxMemory,
+		trafficDomainsState:                            trafficDomainsState,
+		trafficDomainsEntities:                         trafficDomainsEntities,
+		trafficDomainsCurrentClientConnections:         trafficDomainsCurrentClientConnections,
+		virtualServersInfo:                             virtualServersInfo,
+		csVirtualServersInfo:                           csVirtualServersInfo,
+		gslbVirtualServersInfo:                         gslbVirtualServersInfo,
+		servicesInfo:                                   servicesInfo,
+		serviceGroupsMemberInfo:                        serviceGroupsMemberInfo,
+		persistenceSessions:                            persistenceSessions,
+		connectionTableConnections:                     connectionTableConnections,
+		limitIdentifiersHits:                           limitIdentifiersHits,
+		limitIdentifiersDrops:                          limitIdentifiersDrops,
+		limitIdentifiersCurrentSessions:                limitIdentifiersCurrentSessions,
+		limitIdentifiersThreshold:                      limitIdentifiersThreshold,
+		botProfileDetections:                           botProfileDetections,
+		botProfileActions:                              botProfileActions,
+		username:                                       username,
+		password:                                       password,
+		url:                                            url,
+		ignoreCert:                                     ignoreCert,
+		partition:                                      partition,
+		logger:                                         logger,
+		nsInstance:                                     nsInstance,
 	}, nil
 }
 
@@ -279,6 +820,20 @@ func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
 	e.interfacesJumboPacketsRx.Describe(ch)
 	e.interfacesJumboPacketsTx.Describe(ch)
 	e.interfacesErrorPacketsRx.Describe(ch)
+	e.interfacesErrorPacketsTx.Describe(ch)
+	e.interfacesDroppedPacketsRx.Describe(ch)
+	e.interfacesDroppedPacketsTx.Describe(ch)
+	e.interfacesMulticastPackets.Describe(ch)
+	e.interfacesLinkHangs.Describe(ch)
+	e.interfacesNICRxStalls.Describe(ch)
+	e.interfacesNICTxStalls.Describe(ch)
+	e.interfacesLinkReinits.Describe(ch)
+	e.interfacesLinkUptime.Describe(ch)
+	e.interfacesLinkState.Describe(ch)
+	e.interfacesActualSpeed.Describe(ch)
+	e.interfacesRequestedSpeed.Describe(ch)
+	e.interfacesDuplex.Describe(ch)
+	e.interfacesChannelInfo.Describe(ch)
 
 	e.virtualServersWaitingRequests.Describe(ch)
 	e.virtualServersHealth.Describe(ch)
@@ -292,6 +847,17 @@ func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
 	e.virtualServersCurrentClientConnections.Describe(ch)
 	e.virtualServersCurrentServerConnections.Describe(ch)
 	e.virtualServersState.Describe(ch)
+	e.virtualServersSurgeCount.Describe(ch)
+	e.virtualServersTotalSpillovers.Describe(ch)
+	e.virtualServersTotalVServerDownBackupHits.Describe(ch)
+	e.virtualServersAvgClientTTLB.Describe(ch)
+	e.virtualServersNumberInvalidRequestResponse.Describe(ch)
+	e.virtualServersNumberInvalidRequestResponseDropped.Describe(ch)
+	e.virtualServersTotalPacketsReceived.Describe(ch)
+	e.virtualServersTotalPacketsSent.Describe(ch)
+	e.virtualServersEstablishedConnections.Describe(ch)
+	e.virtualServersDeferredRequests.Describe(ch)
+	e.virtualServersTotalServerBusyErrors.Describe(ch)
 
 	e.servicesThroughput.Describe(ch)
 	e.servicesAvgTTFB.Describe(ch)
@@ -369,4 +935,281 @@ func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
 	e.vpnVirtualServersTotalRequestBytes.Describe(ch)
 	e.vpnVirtualServersTotalResponseBytes.Describe(ch)
 	e.vpnVirtualServersState.Describe(ch)
+
+	e.systemMemoryAllocatedPct.Describe(ch)
+	e.systemMemoryAllocatedBytes.Describe(ch)
+	e.systemMemorySizeBytes.Describe(ch)
+	e.systemMemoryFreeBytes.Describe(ch)
+	e.systemMemoryAllocationFailures.Describe(ch)
+
+	e.hardwareTemperature.Describe(ch)
+	e.hardwareFanSpeed.Describe(ch)
+	e.hardwarePowerSupplyStatus.Describe(ch)
+	e.hardwareDiskUsage.Describe(ch)
+	e.hardwareDiskSize.Describe(ch)
+	e.hardwareDiskAvailable.Describe(ch)
+
+	e.serversState.Describe(ch)
+	e.serversDomainIPAddress.Describe(ch)
+	e.serversBoundServices.Describe(ch)
+	e.serversBoundServiceGroups.Describe(ch)
+
+	e.monitorsServiceState.Describe(ch)
+	e.monitorsServiceResponseTime.Describe(ch)
+	e.monitorsServiceProbes.Describe(ch)
+	e.monitorsServiceFailedProbes.Describe(ch)
+	e.monitorsServiceCurrentFailedProbes.Describe(ch)
+	e.monitorsServiceLastResponse.Describe(ch)
+	e.monitorsServiceGroupState.Describe(ch)
+	e.monitorsServiceGroupMemberState.Describe(ch)
+	e.monitorsServiceGroupMemberResponseTime.Describe(ch)
+	e.monitorsServiceGroupMemberProbes.Describe(ch)
+	e.monitorsServiceGroupMemberFailedProbes.Describe(ch)
+	e.monitorsServiceGroupMemberCurrentFailedProbes.Describe(ch)
+	e.monitorsServiceGroupMemberLastResponse.Describe(ch)
+
+	e.cacheTotalHits.Describe(ch)
+	e.cacheTotalMisses.Describe(ch)
+	e.cacheTotalRequests.Describe(ch)
+	e.cacheTotal304Hits.Describe(ch)
+	e.cacheTotalStoreableMisses.Describe(ch)
+	e.cacheTotalNonStoreableMisses.Describe(ch)
+	e.cacheTotalFlashCacheHits.Describe(ch)
+	e.cacheTotalFlashCacheMisses.Describe(ch)
+	e.cacheServedBytes.Describe(ch)
+	e.cacheOriginBandwidthSaved.Describe(ch)
+	e.cacheCachedObjects.Describe(ch)
+	e.cacheMemoryUsed.Describe(ch)
+	e.cacheMemoryMax.Describe(ch)
+
+	e.cacheContentGroupMemoryUsed.Describe(ch)
+	e.cacheContentGroupCachedObjects.Describe(ch)
+	e.cacheContentGroupHits.Describe(ch)
+	e.cacheContentGroupMisses.Describe(ch)
+
+	e.compressionHTTPTotalRequests.Describe(ch)
+	e.compressionHTTPTotalRxBytes.Describe(ch)
+	e.compressionHTTPTotalTxBytes.Describe(ch)
+	e.compressionHTTPTotalRxPackets.Describe(ch)
+	e.compressionHTTPTotalTxPackets.Describe(ch)
+	e.compressionHTTPRatio.Describe(ch)
+	e.compressionHTTPBandwidthSaving.Describe(ch)
+	e.compressionTCPTotalRxBytes.Describe(ch)
+	e.compressionTCPTotalTxBytes.Describe(ch)
+	e.compressionTCPRatio.Describe(ch)
+	e.compressionTCPBandwidthSaving.Describe(ch)
+
+	e.compressionPolicyHits.Describe(ch)
+
+	e.appfwRequests.Describe(ch)
+	e.appfwResponses.Describe(ch)
+	e.appfwRequestBytes.Describe(ch)
+	e.appfwResponseBytes.Describe(ch)
+	e.appfwAborts.Describe(ch)
+	e.appfwRedirects.Describe(ch)
+	e.appfwViolations.Describe(ch)
+	e.appfwCheckViolations.Describe(ch)
+
+	e.appfwProfileRequests.Describe(ch)
+	e.appfwProfileResponses.Describe(ch)
+	e.appfwProfileAborts.Describe(ch)
+	e.appfwProfileRedirects.Describe(ch)
+	e.appfwProfileViolations.Describe(ch)
+	e.appfwProfileCheckViolations.Describe(ch)
+
+	e.policyHits.Describe(ch)
+	e.policyUndefHits.Describe(ch)
+	e.policyBindingInfo.Describe(ch)
+
+	e.protocolTCPRxPackets.Describe(ch)
+	e.protocolTCPRxBytes.Describe(ch)
+	e.protocolTCPTxPackets.Describe(ch)
+	e.protocolTCPTxBytes.Describe(ch)
+	e.protocolTCPClientConnections.Describe(ch)
+	e.protocolTCPClientConnectionsEstablished.Describe(ch)
+	e.protocolTCPServerConnections.Describe(ch)
+	e.protocolTCPServerConnectionsEstablished.Describe(ch)
+	e.protocolTCPSurgeQueueLength.Describe(ch)
+	e.protocolTCPSpareConnections.Describe(ch)
+	e.protocolTCPSyn.Describe(ch)
+	e.protocolTCPSynProbes.Describe(ch)
+	e.protocolTCPSynHeld.Describe(ch)
+	e.protocolTCPSynDroppedCongestion.Describe(ch)
+	e.protocolTCPSynRetries.Describe(ch)
+	e.protocolTCPSynGiveUps.Describe(ch)
+	e.protocolTCPSynCookieSeqRejects.Describe(ch)
+	e.protocolTCPSynCookieSignatureRejects.Describe(ch)
+	e.protocolTCPSynCookieSeqDrops.Describe(ch)
+	e.protocolTCPSynCookieMSSRejects.Describe(ch)
+	e.protocolTCPRetransmits.Describe(ch)
+	e.protocolTCPFirstRetransmits.Describe(ch)
+	e.protocolTCPClientRetransmits.Describe(ch)
+	e.protocolTCPServerRetransmits.Describe(ch)
+	e.protocolTCPRetransmitGiveUps.Describe(ch)
+	e.protocolTCPRstReceived.Describe(ch)
+	e.protocolTCPRstSent.Describe(ch)
+	e.protocolTCPRstOutOfWindow.Describe(ch)
+	e.protocolTCPRstNonEstablished.Describe(ch)
+	e.protocolTCPBadChecksums.Describe(ch)
+	e.protocolTCPStrayPackets.Describe(ch)
+	e.protocolTCPClientOutOfOrder.Describe(ch)
+	e.protocolTCPServerOutOfOrder.Describe(ch)
+
+	e.protocolHTTPRequests.Describe(ch)
+	e.protocolHTTPResponses.Describe(ch)
+	e.protocolHTTPGetRequests.Describe(ch)
+	e.protocolHTTPPostRequests.Describe(ch)
+	e.protocolHTTPOtherRequests.Describe(ch)
+	e.protocolHTTPRequestBytes.Describe(ch)
+	e.protocolHTTPResponseBytes.Describe(ch)
+	e.protocolHTTPHTTP10Requests.Describe(ch)
+	e.protocolHTTPHTTP11Requests.Describe(ch)
+	e.protocolHTTPChunkedRequests.Describe(ch)
+	e.protocolHTTPChunkedResponses.Describe(ch)
+	e.protocolHTTPIncompleteRequests.Describe(ch)
+	e.protocolHTTPIncompleteResponses.Describe(ch)
+	e.protocolHTTPIncompleteHeaders.Describe(ch)
+	e.protocolHTTPLargeContent.Describe(ch)
+	e.protocolHTTPLargeChunk.Describe(ch)
+	e.protocolHTTPInvalidContentLength.Describe(ch)
+	e.protocolHTTPServerBusy.Describe(ch)
+
+	e.protocolIPRxPackets.Describe(ch)
+	e.protocolIPRxBytes.Describe(ch)
+	e.protocolIPTxPackets.Describe(ch)
+	e.protocolIPTxBytes.Describe(ch)
+	e.protocolIPFragments.Describe(ch)
+	e.protocolIPReassemblyAttempts.Describe(ch)
+	e.protocolIPReassembled.Describe(ch)
+	e.protocolIPReassemblyFailures.Describe(ch)
+	e.protocolIPTooBig.Describe(ch)
+	e.protocolIPDuplicateFragments.Describe(ch)
+	e.protocolIPOutOfOrderFragments.Describe(ch)
+	e.protocolIPZeroLengthFragments.Describe(ch)
+	e.protocolIPBadChecksums.Describe(ch)
+	e.protocolIPTTLExpired.Describe(ch)
+	e.protocolIPAddressLookups.Describe(ch)
+	e.protocolIPAddressLookupFailures.Describe(ch)
+
+	e.protocolUDPRxPackets.Describe(ch)
+	e.protocolUDPRxBytes.Describe(ch)
+	e.protocolUDPTxPackets.Describe(ch)
+	e.protocolUDPTxBytes.Describe(ch)
+	e.protocolUDPUnknownServicePackets.Describe(ch)
+	e.protocolUDPBadChecksums.Describe(ch)
+	e.protocolUDPRateThresholdExceeded.Describe(ch)
+
+	e.protocolICMPRxPackets.Describe(ch)
+	e.protocolICMPRxBytes.Describe(ch)
+	e.protocolICMPTxPackets.Describe(ch)
+	e.protocolICMPTxBytes.Describe(ch)
+	e.protocolICMPEchoRequestsReceived.Describe(ch)
+	e.protocolICMPEchoRepliesSent.Describe(ch)
+	e.protocolICMPEchoRequestsSent.Describe(ch)
+	e.protocolICMPEchoRepliesReceived.Describe(ch)
+	e.protocolICMPRateThresholdExceeded.Describe(ch)
+	e.protocolICMPBadChecksums.Describe(ch)
+
+	e.dnsQueries.Describe(ch)
+	e.dnsResponses.Describe(ch)
+	e.dnsServerQueries.Describe(ch)
+	e.dnsServerResponses.Describe(ch)
+	e.dnsNXDomain.Describe(ch)
+	e.dnsCacheHits.Describe(ch)
+	e.dnsCacheMisses.Describe(ch)
+	e.dnsQueriesByType.Describe(ch)
+
+	e.gslbSitesState.Describe(ch)
+	e.gslbSitesMetricExchangeStatus.Describe(ch)
+	e.gslbSitesNetworkMetricExchangeStatus.Describe(ch)
+	e.gslbSitesTotalRequests.Describe(ch)
+	e.gslbSitesTotalResponses.Describe(ch)
+	e.gslbSitesTotalRequestBytes.Describe(ch)
+	e.gslbSitesTotalResponseBytes.Describe(ch)
+	e.gslbSitesCurrentClientConns.Describe(ch)
+	e.gslbSitesCurrentServerConns.Describe(ch)
+
+	e.aaaCurrentSessions.Describe(ch)
+	e.aaaCurrentICASessions.Describe(ch)
+	e.aaaCurrentICAConnections.Describe(ch)
+	e.aaaCurrentICAOnlyConnections.Describe(ch)
+	e.aaaAuthSuccess.Describe(ch)
+	e.aaaAuthFailures.Describe(ch)
+	e.aaaSessionsTotal.Describe(ch)
+	e.aaaSessionTimeouts.Describe(ch)
+	e.aaaCurrentTMSessions.Describe(ch)
+	e.aaaTMSessionsTotal.Describe(ch)
+	e.aaaHTTPAuthSuccess.Describe(ch)
+	e.aaaHTTPAuthFailures.Describe(ch)
+	e.aaaNonHTTPAuthSuccess.Describe(ch)
+	e.aaaNonHTTPAuthFailures.Describe(ch)
+	e.authenticationVirtualServersState.Describe(ch)
+	e.authenticationVirtualServersTotalRequests.Describe(ch)
+	e.authenticationVirtualServersTotalResponses.Describe(ch)
+	e.authenticationVirtualServersTotalRequestBytes.Describe(ch)
+	e.authenticationVirtualServersTotalResponseBytes.Describe(ch)
+
+	e.vpnLoginPageHits.Describe(ch)
+	e.vpnICALicenseFailures.Describe(ch)
+	e.vpnSTARequests.Describe(ch)
+	e.vpnSTAConnectionSuccess.Describe(ch)
+	e.vpnSTAConnectionFailures.Describe(ch)
+
+	e.channelsMembers.Describe(ch)
+	e.channelsState.Describe(ch)
+	e.channelsMemberState.Describe(ch)
+	e.channelsMemberLACPActorInSync.Describe(ch)
+	e.channelsMemberLACPPartnerInSync.Describe(ch)
+	e.channelsMemberLACPPartnerCollecting.Describe(ch)
+	e.channelsMemberLACPPartnerDistributing.Describe(ch)
+
+	e.vlansRxPackets.Describe(ch)
+	e.vlansRxBytes.Describe(ch)
+	e.vlansTxPackets.Describe(ch)
+	e.vlansTxBytes.Describe(ch)
+	e.vlansDroppedPackets.Describe(ch)
+	e.vlansBroadcastPackets.Describe(ch)
+
+	e.buildInfo.Describe(ch)
+	e.uptimeSeconds.Describe(ch)
+	e.bootTimeSeconds.Describe(ch)
+
+	e.licenseFeatureEnabled.Describe(ch)
+	e.licenseExpiryDays.Describe(ch)
+
+	e.licenseThroughput.Describe(ch)
+	e.licenseActualThroughput.Describe(ch)
+
+	e.configUnsavedChanges.Describe(ch)
+	e.configLastSaveTimestamp.Describe(ch)
+	e.configLastChangeTimestamp.Describe(ch)
+
+	e.partitionsCurrentBandwidth.Describe(ch)
+	e.partitionsMaxBandwidth.Describe(ch)
+	e.partitionsCurrentConnections.Describe(ch)
+	e.partitionsMaxConnections.Describe(ch)
+	e.partitionsMemoryUsage.Describe(ch)
+	e.partitionsMaxMemory.Describe(ch)
+
+	e.trafficDomainsState.Describe(ch)
+	e.trafficDomainsEntities.Describe(ch)
+	e.trafficDomainsCurrentClientConnections.Describe(ch)
+
+	e.virtualServersInfo.Describe(ch)
+	e.csVirtualServersInfo.Describe(ch)
+	e.gslbVirtualServersInfo.Describe(ch)
+
+	e.servicesInfo.Describe(ch)
+	e.serviceGroupsMemberInfo.Describe(ch)
+
+	e.persistenceSessions.Describe(ch)
+	e.connectionTableConnections.Describe(ch)
+
+	e.limitIdentifiersHits.Describe(ch)
+	e.limitIdentifiersDrops.Describe(ch)
+	e.limitIdentifiersCurrentSessions.Describe(ch)
+	e.limitIdentifiersThreshold.Describe(ch)
+
+	e.botProfileDetections.Describe(ch)
+	e.botProfileActions.Describe(ch)
 }
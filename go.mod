@@ -14,7 +14,7 @@ require (
 	github.com/kr/pretty v0.1.0 // indirect
 	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
 	github.com/prometheus/client_golang v0.8.0
-	github.com/prometheus/client_model v0.2.0 // indirect
+	github.com/prometheus/client_model v0.2.0
 	github.com/prometheus/common v0.0.0-20171006141418-1bab55dd05db // indirect
 	github.com/prometheus/procfs v0.0.8 // indirect
 	github.com/stretchr/testify v1.4.0 // indirect
@@ -22,3 +22,6 @@ require (
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 	gopkg.in/yaml.v2 v2.2.5
 )
+
+// SwitchPartition isn't in a published version of the client library yet, so the fork with it is used until it is
+replace github.com/jbvmio/netscaler => ./third_party/netscaler
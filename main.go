@@ -26,6 +26,7 @@ var (
 	bindPort     = flag.Int("bind_port", 9280, "Port to bind the exporter endpoint to")
 	versionFlg   = flag.Bool("version", false, "Display application version")
 	debugFlg     = flag.Bool("debug", false, "Enable debug logging?")
+	sessionsFlg  = flag.Bool("collect_sessions", false, "Count persistence sessions and connections per virtual server?  The full tables are transferred on every scrape")
 	logger       log.Logger
 	nsInstance   string
 	vipDB        *DB
@@ -85,6 +86,7 @@ func main() {
 				<input type="radio" id="no" name="ignore-cert" value="no" checked>
   				<label for="no">No</label>
 				<br>
+				<label>Partition:</label> <input type="text" name="partition" placeholder="default"> <br>
 				<input type="submit" value="Submit">
 				</form>
 				</body>
@@ -118,24 +120,28 @@ func handler(w http.ResponseWriter, r *http.Request) {
 		ignoreCertCheck = true
 	}
 
+	partition := r.URL.Query().Get("partition")
+
 	nsInstance = strings.TrimLeft(target, "https://")
 	nsInstance = strings.TrimLeft(nsInstance, "http://")
 	nsInstance = strings.Trim(nsInstance, " /")
 
 	if *debugFlg {
-		level.Debug(logger).Log("msg", "scraping target", "target", target)
+		level.Debug(logger).Log("msg", "scraping target", "target", target, "partition", partition)
 	}
 
-	there, ready := vipDB.exists(target)
-	loaded := currentMapping.exists(target)
+	key := mappingKey(target, partition)
+	there, ready := vipDB.exists(key)
+	loaded := currentMapping.exists(key)
 	switch {
 	case !there:
-		level.Info(logger).Log("msg", "creating new vip mappings for "+target)
+		level.Info(logger).Log("msg", "creating new vip mappings for "+key)
 		lbs := lbserver{
-			url:    target,
-			user:   *username,
-			pass:   *password,
-			ignore: ignoreCertCheck,
+			url:       target,
+			user:      *username,
+			pass:      *password,
+			ignore:    ignoreCertCheck,
+			partition: partition,
 		}
 		if loaded {
 			vipDB.setLBServer(lbs)
@@ -151,12 +157,12 @@ func handler(w http.ResponseWriter, r *http.Request) {
 	case !ready:
 		if !loaded {
 			w.WriteHeader(http.StatusOK)
-			level.Info(logger).Log("msg", "vip mappings not ready yet for "+target)
+			level.Info(logger).Log("msg", "vip mappings not ready yet for "+key)
 			return
 		}
 	}
 
-	exporter, err := NewExporter(target, *username, *password, ignoreCertCheck, logger, nsInstance)
+	exporter, err := NewExporter(target, *username, *password, ignoreCertCheck, partition, logger, nsInstance)
 	if err != nil {
 		http.Error(w, "Error creating exporter"+err.Error(), 400)
 		level.Error(logger).Log("msg", err)
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/jbvmio/netscaler"
+)
+
+// nitroResponse represents the portions of the Nitro API response which are not covered by netscaler.NSAPIResponse
+type nitroResponse struct {
+	Errorcode                         int64                               `json:"errorcode"`
+	Message                           string                              `json:"message"`
+	Severity                          string                              `json:"severity"`
+	SystemMemoryStats                 systemMemoryStats                   `json:"systemmemory"`
+	SystemStats                       systemStats                         `json:"system"`
+	VirtualServerStats                []virtualServerStats                `json:"lbvserver"`
+	Servers                           []servers                           `json:"server"`
+	ServerServiceBindings             []serverServiceBindings             `json:"server_service_binding"`
+	ServerServiceGroupBindings        []serverServiceGroupBindings        `json:"server_servicegroup_binding"`
+	Monitors                          []monitors                          `json:"lbmonitor"`
+	ServiceMonitorBindings            []serviceMonitorBindings            `json:"service_lbmonitor_binding"`
+	ServiceGroupMonitorBindings       []serviceGroupMonitorBindings       `json:"servicegroup_lbmonitor_binding"`
+	CacheStats                        cacheStats                          `json:"cache"`
+	CacheContentGroups                []cacheContentGroups                `json:"cachecontentgroup"`
+	CompressionStats                  compressionStats                    `json:"cmp"`
+	CompressionPolicies               []compressionPolicies               `json:"cmppolicy"`
+	AppFWStats                        appfwStats                          `json:"appfw"`
+	AppFWProfileStats                 []appfwProfileStats                 `json:"appfwprofile"`
+	ResponderPolicyStats              []policyStats                       `json:"responderpolicy"`
+	ResponderPolicyBindings           []policyBindings                    `json:"responderpolicy_binding"`
+	RewritePolicyStats                []policyStats                       `json:"rewritepolicy"`
+	RewritePolicyBindings             []policyBindings                    `json:"rewritepolicy_binding"`
+	CSPolicyStats                     []policyStats                       `json:"cspolicy"`
+	CSPolicyBindings                  []policyBindings                    `json:"cspolicy_binding"`
+	CachePolicyStats                  []policyStats                       `json:"cachepolicy"`
+	CachePolicyBindings               []policyBindings                    `json:"cachepolicy_binding"`
+	AppFWPolicyStats                  []policyStats                       `json:"appfwpolicy"`
+	AppFWPolicyBindings               []policyBindings                    `json:"appfwpolicy_binding"`
+	ProtocolTCPStats                  protocolTCPStats                    `json:"protocoltcp"`
+	ProtocolHTTPStats                 protocolHTTPStats                   `json:"protocolhttp"`
+	ProtocolIPStats                   protocolIPStats                     `json:"protocolip"`
+	ProtocolUDPStats                  protocolUDPStats                    `json:"protocoludp"`
+	ProtocolICMPStats                 protocolICMPStats                   `json:"protocolicmp"`
+	DNSStats                          dnsStats                            `json:"dns"`
+	GSLBSiteStats                     []gslbSiteStats                     `json:"gslbsite"`
+	AAAStats                          aaaStats                            `json:"aaa"`
+	VPNStats                          vpnStats                            `json:"vpn"`
+	AuthenticationVirtualServerStats  []authenticationVirtualServerStats  `json:"authenticationvserver"`
+	AuthenticationPolicyStats         []policyStats                       `json:"authenticationpolicy"`
+	AuthenticationPolicyBindings      []policyBindings                    `json:"authenticationpolicy_binding"`
+	InterfaceStats                    []interfaceStats                    `json:"Interface"`
+	Interfaces                        []interfaces                        `json:"-"`
+	Channels                          []channels                          `json:"channel"`
+	VLANStats                         []vlanStats                         `json:"vlan"`
+	NSVersion                         nsVersion                           `json:"nsversion"`
+	NSHardware                        nsHardware                          `json:"nshardware"`
+	NSHostname                        nsHostname                          `json:"nshostname"`
+	NSLicense                         nsLicense                           `json:"nslicense"`
+	NSCapacity                        nsCapacity                          `json:"nscapacity"`
+	NSConfig                          nsConfig                            `json:"nsconfig"`
+	PartitionStats                    []partitionStats                    `json:"nspartition"`
+	TrafficDomains                    []trafficDomains                    `json:"nstrafficdomain"`
+	VirtualServers                    []virtualServerConfig               `json:"-"`
+	CSVirtualServers                  []virtualServerConfig               `json:"csvserver"`
+	GSLBVirtualServers                []virtualServerConfig               `json:"gslbvserver"`
+	Services                          []serviceConfig                     `json:"service"`
+	VirtualServerServiceBindings      []virtualServerServiceBindings      `json:"lbvserver_service_binding"`
+	ServiceGroups                     []serviceGroupConfig                `json:"servicegroup"`
+	ServiceGroupMemberBindings        []serviceGroupMemberBindings        `json:"servicegroup_servicegroupmember_binding"`
+	PersistentSessions                []persistentSessions                `json:"lbpersistentsessions"`
+	Connections                       []connections                       `json:"nsconnectiontable"`
+	LimitIdentifiers                  []limitIdentifiers                  `json:"nslimitidentifier"`
+	LimitSessions                     []limitSessions                     `json:"nslimitsessions"`
+	BotPolicyStats                    []policyStats                       `json:"botpolicy"`
+	BotPolicyBindings                 []policyBindings                    `json:"botpolicy_binding"`
+	BotProfileStats                   []botProfileStats                   `json:"botprofile"`
+	ServiceGroupMemberMonitorBindings []serviceGroupMemberMonitorBindings `json:"servicegroup_servicegroupentitymonbindings_binding"`
+	CompressionActions                []compressionActions                `json:"cmpaction"`
+}
+
+// getStats queries the Nitro API for stats of the given type
+func getStats(c *netscaler.NitroClient, statsType string, querystring string) (nitroResponse, error) {
+	stats, err := c.GetStats(statsType, querystring)
+	if err != nil {
+		return nitroResponse{}, err
+	}
+
+	var response = new(nitroResponse)
+
+	err = json.Unmarshal(stats, &response)
+	if err != nil {
+		return nitroResponse{}, errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	return *response, nil
+}
+
+// getConfig queries the Nitro API for configuration of the given type
+func getConfig(c *netscaler.NitroClient, configType string, querystring string) (nitroResponse, error) {
+	cfg, err := c.GetConfig(configType, querystring)
+	if err != nil {
+		return nitroResponse{}, err
+	}
+
+	var response = new(nitroResponse)
+
+	err = json.Unmarshal(cfg, &response)
+	if err != nil {
+		return nitroResponse{}, errors.New("error unmarshalling response body: " + err.Error())
+	}
+
+	return *response, nil
+}
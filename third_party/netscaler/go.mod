@@ -0,0 +1,5 @@
+module github.com/jbvmio/netscaler
+
+go 1.12
+
+require github.com/pkg/errors v0.9.1
@@ -0,0 +1,54 @@
+package netscaler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// PartitionName contains the name of the admin partition to switch to
+type PartitionName struct {
+	PartitionName string `json:"partitionname"`
+}
+
+// SwitchPartitionPayload is the request body that needs to be sent to NetScaler to switch partition
+type SwitchPartitionPayload struct {
+	Partition PartitionName `json:"nspartition"`
+}
+
+// SwitchPartition switches the session into the given admin partition.  Every request made afterwards on the session
+// is made against the entities in that partition.  The client must already be connected
+func SwitchPartition(c *NitroClient, partition string) error {
+	url := c.url + "config/nspartition?action=switch"
+	p := SwitchPartitionPayload{
+		Partition: PartitionName{
+			PartitionName: partition,
+		},
+	}
+	reqBody, err := json.Marshal(p)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling payload")
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "error creating HTTP request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrap(err, "error sending request")
+	}
+	switch resp.StatusCode {
+	case 200, 201:
+		return nil
+	default:
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errors.New("partition switch failed: " + resp.Status + " (" + string(body) + ")")
+	}
+}